@@ -0,0 +1,364 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"sort"
+)
+
+// generator generates configuration of a bucket with its tests, steps,
+// environments and schedules, and import blocks for all of them.
+type generator struct {
+	client   *runscope.Client
+	bucketId string
+
+	names   names
+	envRefs map[string]string
+	imports *file
+}
+
+func newGenerator(client *runscope.Client, bucketId string) *generator {
+	return &generator{
+		client:   client,
+		bucketId: bucketId,
+		names:    names{},
+		envRefs:  map[string]string{},
+		imports:  &file{name: "imports.tf"},
+	}
+}
+
+// generate returns files of configuration: bucket.tf with the bucket and
+// shared environments, a file per test and imports.tf.
+func (g *generator) generate(ctx context.Context) ([]*file, error) {
+	bucket, err := g.client.Bucket.Get(ctx, &runscope.BucketGetOpts{Key: g.bucketId})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read bucket: %s", err)
+	}
+
+	tests, err := g.readTests(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedEnvs, err := g.readSharedEnvironments(ctx, tests)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketFile := &file{name: "bucket.tf"}
+	bucketName := g.names.name("runscope_bucket", bucket.Name)
+	bucketRef := "runscope_bucket." + bucketName + ".id"
+
+	b := newBlock("resource", "runscope_bucket", bucketName)
+	b.string("name", bucket.Name)
+	b.string("team_uuid", bucket.Team.UUID)
+	b.bool("verify_ssl", bucket.VerifySSL, true)
+	bucketFile.add(b)
+	g.addImport("runscope_bucket."+bucketName, bucket.Key)
+
+	// References are assigned before environments are generated,
+	// as environments may refer to each other as parents.
+	envNames := map[string]string{}
+	for _, env := range sharedEnvs {
+		envNames[env.Id] = g.names.name("runscope_environment", env.Name)
+		g.envRefs[env.Id] = "runscope_environment." + envNames[env.Id] + ".id"
+	}
+	for _, test := range tests {
+		for _, env := range test.Environments {
+			envNames[env.Id] = g.names.name("runscope_environment", test.Name+"_"+env.Name)
+			g.envRefs[env.Id] = "runscope_environment." + envNames[env.Id] + ".id"
+		}
+	}
+
+	for _, env := range sharedEnvs {
+		bucketFile.add(g.environment(envNames[env.Id], bucketRef, "", env))
+		g.addImport("runscope_environment."+envNames[env.Id], g.bucketId+"/"+env.Id)
+	}
+
+	files := []*file{bucketFile}
+	fileNames := names{}
+	for _, test := range tests {
+		files = append(files, g.test(test, bucketRef, envNames, fileNames))
+	}
+
+	return append(files, g.imports), nil
+}
+
+// readTests returns all tests of the bucket with their steps,
+// environments and schedules.
+func (g *generator) readTests(ctx context.Context) ([]*runscope.Test, error) {
+	list, err := g.client.Test.ListAll(ctx, runscope.TestListOpts{BucketId: g.bucketId})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't list tests: %s", err)
+	}
+
+	tests := make([]*runscope.Test, len(list))
+	for i, t := range list {
+		tests[i], err = g.client.Test.Get(ctx, runscope.TestGetOpts{BucketId: g.bucketId, Id: t.Id})
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read test %s: %s", t.Id, err)
+		}
+	}
+
+	return tests, nil
+}
+
+// readSharedEnvironments returns shared environments of the bucket used
+// by the tests as default, by schedules or as parents of other environments.
+func (g *generator) readSharedEnvironments(ctx context.Context, tests []*runscope.Test) ([]*runscope.Environment, error) {
+	known := map[string]bool{}
+	var ids []string
+	for _, test := range tests {
+		for _, env := range test.Environments {
+			known[env.Id] = true
+		}
+	}
+	for _, test := range tests {
+		ids = append(ids, test.DefaultEnvironmentId)
+		for _, env := range test.Environments {
+			ids = append(ids, env.ParentEnvironmentId)
+		}
+		for _, schedule := range test.Schedules {
+			ids = append(ids, schedule.EnvironmentId)
+		}
+	}
+
+	var envs []*runscope.Environment
+	for len(ids) > 0 {
+		id := ids[0]
+		ids = ids[1:]
+		if id == "" || known[id] {
+			continue
+		}
+		known[id] = true
+
+		opts := &runscope.EnvironmentGetOpts{Id: id}
+		opts.BucketId = g.bucketId
+		env, err := g.client.Environment.Get(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read environment %s: %s", id, err)
+		}
+		envs = append(envs, env)
+		ids = append(ids, env.ParentEnvironmentId)
+	}
+
+	return envs, nil
+}
+
+func (g *generator) addImport(to, id string) {
+	b := newBlock("import")
+	b.attr("to", to)
+	b.string("id", id)
+	g.imports.add(b)
+}
+
+// environmentRef returns reference to the environment resource,
+// or the quoted ID if the environment isn't generated.
+func (g *generator) environmentRef(id string) string {
+	if ref, ok := g.envRefs[id]; ok {
+		return ref
+	}
+	return hclString(id)
+}
+
+func (g *generator) test(test *runscope.Test, bucketRef string, envNames map[string]string, fileNames names) *file {
+	f := &file{name: fileNames.name("file", "test_"+test.Name) + ".tf"}
+
+	testName := g.names.name("runscope_test", test.Name)
+	testRef := "runscope_test." + testName + ".id"
+
+	b := newBlock("resource", "runscope_test", testName)
+	b.attr("bucket_id", bucketRef)
+	b.string("name", test.Name)
+	b.string("description", test.Description)
+	f.add(b)
+	g.addImport("runscope_test."+testName, g.bucketId+"/"+test.Id)
+
+	for _, env := range test.Environments {
+		f.add(g.environment(envNames[env.Id], bucketRef, testRef, env))
+		g.addImport("runscope_environment."+envNames[env.Id], g.bucketId+"/"+test.Id+"/"+env.Id)
+	}
+
+	// Steps are generated as runscope_step resources, which the imported
+	// runscope_test leaves alone without manage_steps. Steps depend on the
+	// previous ones, so that they are created in order.
+	previous := ""
+	for i := range test.Steps {
+		step := &test.Steps[i]
+		name := g.names.name("runscope_step", fmt.Sprintf("%s_%d", test.Name, i+1))
+
+		b := newBlock("resource", "runscope_step", name)
+		b.attr("bucket_id", bucketRef)
+		b.attr("test_id", testRef)
+		g.step(b, &step.StepBase)
+		if previous != "" {
+			b.attr("depends_on", "[runscope_step."+previous+"]")
+		}
+		f.add(b)
+		g.addImport("runscope_step."+name, g.bucketId+"/"+test.Id+"/"+step.Id)
+		previous = name
+	}
+
+	for i, schedule := range test.Schedules {
+		name := g.names.name("runscope_schedule", fmt.Sprintf("%s_%d", test.Name, i+1))
+
+		b := newBlock("resource", "runscope_schedule", name)
+		b.attr("bucket_id", bucketRef)
+		b.attr("test_id", testRef)
+		b.attr("environment_id", g.environmentRef(schedule.EnvironmentId))
+		b.string("interval", schedule.Interval)
+		b.string("note", schedule.Note)
+		f.add(b)
+		g.addImport("runscope_schedule."+name, g.bucketId+"/"+test.Id+"/"+schedule.Id)
+	}
+
+	return f
+}
+
+func (g *generator) environment(name, bucketRef, testRef string, env *runscope.Environment) *block {
+	b := newBlock("resource", "runscope_environment", name)
+	b.attr("bucket_id", bucketRef)
+	if testRef != "" {
+		b.attr("test_id", testRef)
+	}
+	b.string("name", env.Name)
+	if env.ParentEnvironmentId != "" {
+		b.attr("parent_environment_id", g.environmentRef(env.ParentEnvironmentId))
+	}
+	b.string("script", env.Script)
+	b.bool("preserve_cookies", env.PreserveCookies, false)
+	b.bool("retry_on_failure", env.RetryOnFailure, false)
+	b.bool("stop_on_failure", env.StopOnFailure, false)
+	b.bool("verify_ssl", env.VerifySSL, true)
+	b.stringMap("initial_variables", env.InitialVariables)
+	b.strings("integrations", env.Integrations)
+	b.strings("regions", env.Regions)
+	b.strings("webhooks", env.Webhooks)
+	b.string("client_certificate", env.ClientCertificate)
+
+	for _, agent := range env.RemoteAgents {
+		ra := b.block("remote_agent")
+		ra.string("name", agent.Name)
+		ra.string("uuid", agent.UUID)
+	}
+
+	if !env.Emails.IsDefault() {
+		e := b.block("email")
+		e.bool("notify_all", env.Emails.NotifyAll, false)
+		e.string("notify_on", env.Emails.NotifyOn)
+		e.int("notify_threshold", env.Emails.NotifyThreshold)
+		for _, r := range env.Emails.Recipients {
+			e.block("recipient").string("id", r.Id)
+		}
+	}
+
+	return b
+}
+
+func (g *generator) step(b *block, step *runscope.StepBase) {
+	b.string("step_type", step.StepType)
+	b.string("note", step.Note)
+	b.bool("skipped", step.Skipped, false)
+
+	switch step.StepType {
+	case "pause":
+		b.block("pause").int("duration", step.Duration)
+	case "condition":
+		c := b.block("condition")
+		c.string("left_value", step.LeftValue)
+		c.string("comparison", step.Comparison)
+		c.string("right_value", step.RightValue)
+		for i := range step.Steps {
+			s := c.block("step")
+			s.string("note", step.Steps[i].Note)
+			s.bool("skipped", step.Steps[i].Skipped, false)
+			g.stepRequest(s, &step.Steps[i].StepBase)
+		}
+	case "subtest":
+		s := b.block("subtest")
+		s.string("test_uuid", step.TestUUID)
+		s.string("environment_uuid", step.EnvironmentUUID)
+		s.string("bucket_key", step.BucketKey)
+		for _, p := range step.Params {
+			param := s.block("param")
+			param.string("name", p.Name)
+			param.string("value", p.Value)
+		}
+	case "ghost":
+		gi := b.block("ghost_inspector")
+		gi.string("integration_id", step.IntegrationId)
+		gi.string("test_id", step.GhostTestId)
+		gi.string("start_url", step.StartURL)
+	}
+
+	g.stepRequest(b, step)
+}
+
+// stepRequest adds attributes of request steps, which are also
+// shared by scripts, variables and assertions of other step types.
+func (g *generator) stepRequest(b *block, step *runscope.StepBase) {
+	b.string("method", step.Method)
+	b.string("url", step.StepURL)
+	b.string("body", step.Body)
+	b.strings("before_scripts", step.BeforeScripts)
+	b.strings("scripts", step.Scripts)
+
+	for _, header := range sortedKeys(step.Headers) {
+		for _, value := range step.Headers[header] {
+			h := b.block("header")
+			h.string("header", header)
+			h.string("value", value)
+		}
+	}
+
+	if !step.Auth.Empty() {
+		a := b.block("auth")
+		a.string("auth_type", step.Auth.AuthType)
+		a.string("username", step.Auth.Username)
+		a.string("password", step.Auth.Password)
+	}
+
+	for _, name := range sortedKeys(step.Form) {
+		for _, value := range step.Form[name] {
+			fp := b.block("form_parameter")
+			fp.string("name", name)
+			fp.string("value", value)
+		}
+	}
+
+	for _, part := range step.MultipartForm {
+		p := b.block("multipart_form_parameter")
+		p.string("name", part.Name)
+		p.string("value", part.Value)
+		if part.Type != "" && part.Type != "text" {
+			p.string("type", part.Type)
+		}
+		p.string("filename", part.Filename)
+		p.string("content_type", part.ContentType)
+	}
+
+	for _, v := range step.Variables {
+		variable := b.block("variable")
+		variable.string("name", v.Name)
+		variable.string("source", v.Source)
+		variable.string("property", v.Property)
+	}
+
+	for _, a := range step.Assertions {
+		assertion := b.block("assertion")
+		assertion.string("source", a.Source)
+		assertion.string("property", a.Property)
+		assertion.string("comparison", a.Comparison)
+		assertion.string("value", a.Value)
+	}
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscopetest"
+)
+
+func TestHclString(t *testing.T) {
+	cases := map[string]string{
+		`plain`:                `"plain"`,
+		`{{token}}`:            `"{{token}}"`,
+		`say "hi"`:             `"say \"hi\""`,
+		"a\nb\\c":              `"a\nb\\c"`,
+		`${var} %{if}`:         `"$${var} %%{if}"`,
+		`{"id": "${user_id}"}`: `"{\"id\": \"$${user_id}\"}"`,
+	}
+	for s, expected := range cases {
+		if actual := hclString(s); actual != expected {
+			t.Errorf("hclString(%q) = %s, expected %s", s, actual, expected)
+		}
+	}
+}
+
+func TestNames(t *testing.T) {
+	n := names{}
+	for _, c := range []struct{ typ, s, expected string }{
+		{"runscope_test", "Login API", "login_api"},
+		{"runscope_test", "login-api", "login_api_2"},
+		{"runscope_step", "login-api", "login_api"},
+		{"runscope_test", "2fa", "r_2fa"},
+		{"runscope_test", "!!!", "r_"},
+	} {
+		if actual := n.name(c.typ, c.s); actual != c.expected {
+			t.Errorf("name(%q, %q) = %s, expected %s", c.typ, c.s, actual, c.expected)
+		}
+	}
+}
+
+func TestBlock(t *testing.T) {
+	b := newBlock("resource", "runscope_step", "main")
+	b.attr("bucket_id", "runscope_bucket.main.id")
+	b.string("step_type", "request")
+	b.string("note", "")
+	b.bool("skipped", false, false)
+	a := b.block("assertion")
+	a.string("source", "response_status")
+	a.string("comparison", "equal_number")
+	a.string("value", "200")
+	b.strings("scripts", []string{"log(1);"})
+
+	f := &file{}
+	f.add(b)
+	f.comment("the end")
+
+	expected := `resource "runscope_step" "main" {
+  bucket_id = runscope_bucket.main.id
+  step_type = "request"
+
+  assertion {
+    source     = "response_status"
+    comparison = "equal_number"
+    value      = "200"
+  }
+
+  scripts = ["log(1);"]
+}
+
+# the end
+`
+	if actual := string(f.bytes()); actual != expected {
+		t.Errorf("unexpected rendering:\n%s\nexpected:\n%s", actual, expected)
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	t.Cleanup(server.Close)
+	client := runscope.NewClient(runscope.WithEndpoint(server.URL), runscope.WithRetryMax(0))
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "My Bucket", TeamUUID: "c8ffd67b-c281-45d3-9735-3f40ee567a02"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	envOpts := &runscope.EnvironmentCreateOpts{}
+	envOpts.BucketId = bucket.Key
+	envOpts.Name = "shared"
+	envOpts.InitialVariables = map[string]string{"host": "example.com"}
+	envOpts.VerifySSL = true
+	sharedEnv, err := client.Environment.Create(ctx, envOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests []*runscope.Test
+	for _, name := range []string{"login", "Login"} {
+		opts := runscope.TestCreateOpts{BucketId: bucket.Key}
+		opts.Name = name
+		test, err := client.Test.Create(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tests = append(tests, test)
+	}
+
+	uriOpts := runscope.StepUriOpts{BucketId: bucket.Key, TestId: tests[0].Id}
+	stepOpts := &runscope.StepCreateOpts{StepUriOpts: uriOpts}
+	stepOpts.StepType = "request"
+	stepOpts.Method = "POST"
+	stepOpts.StepURL = "https://{{host}}/login"
+	stepOpts.Body = `{"user": "${user}"}`
+	stepOpts.Headers = map[string][]string{"Content-Type": {"application/json"}}
+	stepOpts.Assertions = []runscope.StepAssertion{{Source: "response_status", Comparison: "equal_number", Value: "200"}}
+	step, err := client.Step.Create(ctx, stepOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stepOpts = &runscope.StepCreateOpts{StepUriOpts: uriOpts}
+	stepOpts.StepType = "pause"
+	stepOpts.Duration = 5
+	if _, err := client.Step.Create(ctx, stepOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	scheduleOpts := &runscope.ScheduleCreateOpts{}
+	scheduleOpts.BucketId = bucket.Key
+	scheduleOpts.TestId = tests[0].Id
+	scheduleOpts.EnvironmentId = sharedEnv.Id
+	scheduleOpts.Interval = "1h"
+	if _, err := client.Schedule.Create(ctx, scheduleOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := newGenerator(client, bucket.Key).generate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := map[string]string{}
+	for _, f := range files {
+		content[f.name] = string(f.bytes())
+	}
+	if len(content) != 4 {
+		t.Fatalf("expected bucket.tf, two test files and imports.tf, got %v", files)
+	}
+
+	for name, expected := range map[string][]string{
+		"bucket.tf": {
+			`resource "runscope_bucket" "my_bucket" {`,
+			`resource "runscope_environment" "shared" {`,
+			`"host" = "example.com"`,
+		},
+		"test_login.tf": {
+			`resource "runscope_test" "login" {`,
+			`resource "runscope_environment" "login_test_settings" {`,
+			`test_id   = runscope_test.login.id`,
+			`resource "runscope_step" "login_1" {`,
+			`url       = "https://{{host}}/login"`,
+			`body      = "{\"user\": \"$${user}\"}"`,
+			`header = "Content-Type"`,
+			`comparison = "equal_number"`,
+			`resource "runscope_step" "login_2" {`,
+			`duration = 5`,
+			`depends_on = [runscope_step.login_1]`,
+			`environment_id = runscope_environment.shared.id`,
+			`interval       = "1h"`,
+		},
+		"test_login_2.tf": {
+			`resource "runscope_test" "login_2" {`,
+		},
+		"imports.tf": {
+			`to = runscope_bucket.my_bucket`,
+			`id = "` + bucket.Key + `"`,
+			`id = "` + bucket.Key + "/" + tests[0].Id + `"`,
+			`id = "` + bucket.Key + "/" + tests[0].Id + "/" + step.Id + `"`,
+			`to = runscope_environment.shared`,
+			`id = "` + bucket.Key + "/" + sharedEnv.Id + `"`,
+			`to = runscope_schedule.`,
+		},
+	} {
+		for _, s := range expected {
+			if !strings.Contains(content[name], s) {
+				t.Errorf("expected %s to contain %s, got:\n%s", name, s, content[name])
+			}
+		}
+	}
+}
+
+func TestGenerator_testSteps(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	t.Cleanup(server.Close)
+	client := runscope.NewClient(runscope.WithEndpoint(server.URL), runscope.WithRetryMax(0))
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: "c8ffd67b-c281-45d3-9735-3f40ee567a02"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := runscope.TestCreateOpts{BucketId: bucket.Key}
+	opts.Name = "checkout"
+	test, err := client.Test.Create(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var steps []*runscope.Step
+	for _, url := range []string{"https://example.com/cart", "https://example.com/pay"} {
+		stepOpts := &runscope.StepCreateOpts{StepUriOpts: runscope.StepUriOpts{BucketId: bucket.Key, TestId: test.Id}}
+		stepOpts.StepType = "request"
+		stepOpts.Method = "GET"
+		stepOpts.StepURL = url
+		step, err := client.Step.Create(ctx, stepOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		steps = append(steps, step)
+	}
+
+	files, err := newGenerator(client, bucket.Key).generate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := map[string]string{}
+	for _, f := range files {
+		content[f.name] = string(f.bytes())
+	}
+
+	// Steps must not be taken over by the imported test, otherwise the
+	// first apply would delete them.
+	testFile := content["test_checkout.tf"]
+	testBlock := testFile[strings.Index(testFile, `resource "runscope_test" "checkout" {`):]
+	testBlock = testBlock[:strings.Index(testBlock, "\n}\n")]
+	if strings.Contains(testBlock, "step {") || strings.Contains(testBlock, "manage_steps") {
+		t.Errorf("expected runscope_test without steps, got:\n%s", testBlock)
+	}
+
+	for name, expected := range map[string][]string{
+		"test_checkout.tf": {
+			`resource "runscope_step" "checkout_1" {`,
+			`url       = "https://example.com/cart"`,
+			`resource "runscope_step" "checkout_2" {`,
+			`url        = "https://example.com/pay"`,
+			`depends_on = [runscope_step.checkout_1]`,
+		},
+		"imports.tf": {
+			`to = runscope_test.checkout`,
+			`id = "` + bucket.Key + "/" + test.Id + `"`,
+			`to = runscope_step.checkout_1`,
+			`id = "` + bucket.Key + "/" + test.Id + "/" + steps[0].Id + `"`,
+			`to = runscope_step.checkout_2`,
+			`id = "` + bucket.Key + "/" + test.Id + "/" + steps[1].Id + `"`,
+		},
+	} {
+		for _, s := range expected {
+			if !strings.Contains(content[name], s) {
+				t.Errorf("expected %s to contain %s, got:\n%s", name, s, content[name])
+			}
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// block is HCL block with attributes and nested blocks, rendered
+// in the order they were added, formatted the same as terraform fmt does.
+type block struct {
+	header string
+	items  []interface{}
+}
+
+type attribute struct {
+	name  string
+	value string
+}
+
+type comment string
+
+func newBlock(typ string, labels ...string) *block {
+	header := typ
+	for _, label := range labels {
+		header += " " + strconv.Quote(label)
+	}
+	return &block{header: header}
+}
+
+// attr adds attribute with rendered value, e.g. by hclString.
+func (b *block) attr(name, value string) {
+	b.items = append(b.items, attribute{name, value})
+}
+
+// string adds string attribute, unless it's empty.
+func (b *block) string(name, value string) {
+	if value != "" {
+		b.attr(name, hclString(value))
+	}
+}
+
+// bool adds bool attribute, unless it's equal to default value.
+func (b *block) bool(name string, value, defaultValue bool) {
+	if value != defaultValue {
+		b.attr(name, strconv.FormatBool(value))
+	}
+}
+
+// int adds number attribute, unless it's zero.
+func (b *block) int(name string, value int) {
+	if value != 0 {
+		b.attr(name, strconv.Itoa(value))
+	}
+}
+
+// strings adds list of strings attribute, unless it's empty.
+func (b *block) strings(name string, values []string) {
+	if len(values) > 0 {
+		b.attr(name, hclStringList(values))
+	}
+}
+
+// stringMap adds map of strings attribute, unless it's empty.
+func (b *block) stringMap(name string, values map[string]string) {
+	if len(values) > 0 {
+		b.attr(name, hclStringMap(values))
+	}
+}
+
+func (b *block) block(typ string, labels ...string) *block {
+	nested := newBlock(typ, labels...)
+	b.items = append(b.items, nested)
+	return nested
+}
+
+func (b *block) comment(text string) {
+	b.items = append(b.items, comment(text))
+}
+
+func (b *block) render(buf *bytes.Buffer, indent string) {
+	fmt.Fprintf(buf, "%s%s {\n", indent, b.header)
+
+	inner := indent + "  "
+	for i := 0; i < len(b.items); {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+
+		switch item := b.items[i].(type) {
+		case *block:
+			item.render(buf, inner)
+			i++
+		case comment:
+			fmt.Fprintf(buf, "%s# %s\n", inner, item)
+			i++
+		case attribute:
+			// Consecutive attributes are aligned by equal signs.
+			j := i
+			width := 0
+			for ; j < len(b.items); j++ {
+				a, ok := b.items[j].(attribute)
+				if !ok {
+					break
+				}
+				if len(a.name) > width {
+					width = len(a.name)
+				}
+			}
+			for ; i < j; i++ {
+				a := b.items[i].(attribute)
+				fmt.Fprintf(buf, "%s%-*s = %s\n", inner, width, a.name, a.value)
+			}
+		}
+	}
+
+	fmt.Fprintf(buf, "%s}\n", indent)
+}
+
+// file is HCL file of top level blocks and comments.
+type file struct {
+	name  string
+	items []interface{}
+}
+
+func (f *file) add(b *block) {
+	f.items = append(f.items, b)
+}
+
+func (f *file) comment(text string) {
+	f.items = append(f.items, comment(text))
+}
+
+func (f *file) bytes() []byte {
+	buf := &bytes.Buffer{}
+	for i, item := range f.items {
+		switch item := item.(type) {
+		case *block:
+			if i > 0 {
+				buf.WriteString("\n")
+			}
+			item.render(buf, "")
+		case comment:
+			if i > 0 {
+				if _, ok := f.items[i-1].(comment); !ok {
+					buf.WriteString("\n")
+				}
+			}
+			fmt.Fprintf(buf, "# %s\n", item)
+		}
+	}
+	return buf.Bytes()
+}
+
+var hclEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"${", "$${",
+	"%{", "%%{",
+)
+
+// hclString returns quoted HCL string literal. Template sequences are
+// escaped, so that Runscope variables like {{var}} are kept as is.
+func hclString(s string) string {
+	return `"` + hclEscaper.Replace(s) + `"`
+}
+
+func hclStringList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = hclString(v)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+func hclStringMap(values map[string]string) string {
+	keys := make([]string, 0, len(values))
+	width := 0
+	for k := range values {
+		keys = append(keys, k)
+		if len(hclString(k)) > width {
+			width = len(hclString(k))
+		}
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "    %-*s = %s\n", width, hclString(k), hclString(values[k]))
+	}
+	sb.WriteString("  }")
+	return sb.String()
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// names makes unique Terraform resource names.
+type names map[string]bool
+
+// name returns a valid resource name derived from s, unique among names
+// of the same resource type.
+func (n names) name(typ, s string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(s), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+
+	unique := name
+	for i := 2; n[typ+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	n[typ+"."+unique] = true
+	return unique
+}
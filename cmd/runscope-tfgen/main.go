@@ -0,0 +1,72 @@
+// Command runscope-tfgen generates Terraform configuration of an existing
+// Runscope bucket: the bucket, its tests with steps, environments and
+// schedules, and import blocks to bring them under management.
+//
+// Usage:
+//
+//	runscope-tfgen -bucket <bucket_key> [-out <dir>]
+//
+// Access token is read from RUNSCOPE_ACCESS_TOKEN environment variable
+// and API URL from RUNSCOPE_API_URL, same as the provider does.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("runscope-tfgen: ")
+
+	apiURL := os.Getenv("RUNSCOPE_API_URL")
+	if apiURL == "" {
+		apiURL = runscope.DefaultEndpoint
+	}
+
+	// Token isn't the default value of the flag, which is printed by usage.
+	token := flag.String("token", "", "Runscope access token (default $RUNSCOPE_ACCESS_TOKEN)")
+	endpoint := flag.String("api-url", apiURL, "Runscope API URL")
+	bucketId := flag.String("bucket", "", "key of the bucket to generate configuration of")
+	out := flag.String("out", ".", "directory to write configuration files to")
+	flag.Parse()
+
+	if *bucketId == "" {
+		fmt.Fprintln(flag.CommandLine.Output(), "-bucket is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *token == "" {
+		*token = os.Getenv("RUNSCOPE_ACCESS_TOKEN")
+	}
+	if *token == "" {
+		log.Fatal("access token is required, set it with -token or RUNSCOPE_ACCESS_TOKEN")
+	}
+
+	client := runscope.NewClient(
+		runscope.WithToken(*token),
+		runscope.WithEndpoint(*endpoint),
+	)
+
+	files, err := newGenerator(client, *bucketId).generate(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range files {
+		path := filepath.Join(*out, f.name)
+		if err := ioutil.WriteFile(path, f.bytes(), 0644); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("wrote %s", path)
+	}
+}
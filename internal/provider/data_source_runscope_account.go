@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRunscopeAccount() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	account, err := client.Account.Get(ctx)
+	if err != nil {
+		return diag.Errorf("Couldn't read account: %s", err)
+	}
+
+	teams := make([]map[string]interface{}, len(account.Teams))
+	for i, team := range account.Teams {
+		teams[i] = map[string]interface{}{
+			"id":   team.UUID,
+			"name": team.Name,
+		}
+	}
+
+	d.SetId(account.Id)
+	d.Set("name", account.Name)
+	d.Set("email", account.Email)
+	d.Set("created_at", flattenTime(account.CreatedAt))
+	d.Set("teams", teams)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeAccount(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRunscopeAccountConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.runscope_account.current", "id"),
+					resource.TestCheckResourceAttrSet("data.runscope_account.current", "name"),
+					resource.TestCheckResourceAttrSet("data.runscope_account.current", "email"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.runscope_account.current", "teams.*", map[string]string{
+						"id": teamId,
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRunscopeAccountConfig = `
+data "runscope_account" "current" {}
+`
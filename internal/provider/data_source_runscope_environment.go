@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func dataSourceRunscopeEnvironment() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeEnvironmentRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"test_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"script": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"preserve_cookies": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"initial_variables": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"integrations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"remote_agent": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"retry_on_failure": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"stop_on_failure": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"verify_ssl": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"webhooks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"email": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"notify_all": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"notify_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notify_threshold": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"recipient": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"email": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"parent_environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	uriOpts := runscope.EnvironmentUriOpts{
+		BucketId: d.Get("bucket_id").(string),
+		TestId:   d.Get("test_id").(string),
+	}
+
+	var env *runscope.Environment
+	if name, ok := d.GetOk("name"); ok && d.Get("id").(string) == "" {
+		envs, err := client.Environment.List(ctx, &runscope.EnvironmentListOpts{EnvironmentUriOpts: uriOpts})
+		if err != nil {
+			return diag.Errorf("Couldn't list environments: %s", err)
+		}
+
+		var found []*runscope.Environment
+		for _, e := range envs {
+			if e.Name == name {
+				found = append(found, e)
+			}
+		}
+		if len(found) == 0 {
+			return diag.Errorf("Couldn't find environment %q", name)
+		}
+		if len(found) > 1 {
+			return diag.Errorf("Found %d environments named %q, use id to select one", len(found), name)
+		}
+		env = found[0]
+	} else {
+		opts := &runscope.EnvironmentGetOpts{EnvironmentUriOpts: uriOpts, Id: d.Get("id").(string)}
+		var err error
+		if env, err = client.Environment.Get(ctx, opts); err != nil {
+			return diag.Errorf("Couldn't read environment: %s", err)
+		}
+	}
+
+	remoteAgents := make([]map[string]interface{}, len(env.RemoteAgents))
+	for i, ra := range env.RemoteAgents {
+		remoteAgents[i] = map[string]interface{}{
+			"name": ra.Name,
+			"uuid": ra.UUID,
+		}
+	}
+
+	d.SetId(env.Id)
+	d.Set("name", env.Name)
+	d.Set("script", env.Script)
+	d.Set("preserve_cookies", env.PreserveCookies)
+	d.Set("initial_variables", env.InitialVariables)
+	d.Set("integrations", env.Integrations)
+	d.Set("regions", env.Regions)
+	d.Set("remote_agent", remoteAgents)
+	d.Set("retry_on_failure", env.RetryOnFailure)
+	d.Set("stop_on_failure", env.StopOnFailure)
+	d.Set("verify_ssl", env.VerifySSL)
+	d.Set("webhooks", env.Webhooks)
+	if !env.Emails.IsDefault() {
+		d.Set("email", flattenEmails(env.Emails))
+	}
+	d.Set("parent_environment_id", env.ParentEnvironmentId)
+	d.Set("client_certificate", env.ClientCertificate)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeEnvironment(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeEnvironmentConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.runscope_environment.by_name", "id", "runscope_environment.shared", "id"),
+					resource.TestCheckResourceAttr("data.runscope_environment.by_name", "initial_variables.base_url", "https://example.com"),
+					resource.TestCheckResourceAttr("data.runscope_environment.by_name", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.runscope_environment.by_name", "regions.0", "eu1"),
+					resource.TestCheckResourceAttr("data.runscope_environment.by_name", "verify_ssl", "false"),
+					resource.TestCheckResourceAttrPair("data.runscope_environment.by_id", "name", "runscope_environment.test", "name"),
+					resource.TestCheckResourceAttr("data.runscope_environment.by_id", "script", "var a = 1;"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRunscopeEnvironmentConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test"
+}
+
+resource "runscope_environment" "shared" {
+  bucket_id  = runscope_bucket.bucket.id
+  name       = "shared-environment"
+  regions    = ["eu1"]
+  verify_ssl = false
+
+  initial_variables = {
+    base_url = "https://example.com"
+  }
+}
+
+resource "runscope_environment" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+  name      = "test-environment"
+  script    = "var a = 1;"
+}
+
+data "runscope_environment" "by_name" {
+  bucket_id  = runscope_bucket.bucket.id
+  name       = "shared-environment"
+  depends_on = [runscope_environment.shared]
+}
+
+data "runscope_environment" "by_id" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+  id        = runscope_environment.test.id
+}
+`
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRunscopeEnvironments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeEnvironmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"test_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"name"}, false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"environments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeEnvironmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	filters, filtersOk := d.GetOk("filter")
+
+	opts := &runscope.EnvironmentListOpts{}
+	opts.BucketId = d.Get("bucket_id").(string)
+	opts.TestId = d.Get("test_id").(string)
+
+	envs, err := client.Environment.List(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Couldn't list environments: %s", err)
+	}
+
+	ids := []string{}
+	items := []map[string]interface{}{}
+	for _, env := range envs {
+		if filtersOk && !environmentFiltersTest(env, filters.(*schema.Set)) {
+			continue
+		}
+
+		ids = append(ids, env.Id)
+		items = append(items, map[string]interface{}{
+			"id":   env.Id,
+			"name": env.Name,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("ids", ids)
+	d.Set("environments", items)
+
+	return nil
+}
+
+func environmentFiltersTest(env *runscope.Environment, filters *schema.Set) bool {
+	for _, v := range filters.List() {
+		m := v.(map[string]interface{})
+		passed := false
+
+		for _, e := range m["values"].(*schema.Set).List() {
+			if env.Name == e {
+				passed = true
+			}
+		}
+
+		if !passed {
+			return false
+		}
+	}
+	return true
+}
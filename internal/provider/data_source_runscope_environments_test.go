@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeEnvironments(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeEnvironmentsConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.runscope_environments.shared", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.runscope_environments.shared", "ids.0", "runscope_environment.staging", "id"),
+					resource.TestCheckResourceAttr("data.runscope_environments.shared", "environments.0.name", "staging"),
+					resource.TestCheckResourceAttr("data.runscope_environments.test", "ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRunscopeEnvironmentsConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test"
+}
+
+resource "runscope_environment" "staging" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "staging"
+}
+
+resource "runscope_environment" "production" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "production"
+}
+
+resource "runscope_environment" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+  name      = "test-environment"
+}
+
+data "runscope_environments" "shared" {
+  bucket_id = runscope_bucket.bucket.id
+
+  filter {
+    name   = "name"
+    values = ["staging"]
+  }
+
+  depends_on = [runscope_environment.staging, runscope_environment.production]
+}
+
+data "runscope_environments" "test" {
+  bucket_id  = runscope_bucket.bucket.id
+  test_id    = runscope_test.test.id
+  depends_on = [runscope_environment.test]
+}
+`
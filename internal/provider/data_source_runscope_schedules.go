@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRunscopeSchedules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeSchedulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"test_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"environment_id", "interval", "note"}, false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"schedules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interval": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"note": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	filters, filtersOk := d.GetOk("filter")
+
+	opts := &runscope.ScheduleListOpts{}
+	opts.BucketId = d.Get("bucket_id").(string)
+	opts.TestId = d.Get("test_id").(string)
+
+	schedules, err := client.Schedule.List(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Couldn't list schedules: %s", err)
+	}
+
+	ids := []string{}
+	items := []map[string]interface{}{}
+	for _, schedule := range schedules {
+		if filtersOk && !scheduleFiltersTest(schedule, filters.(*schema.Set)) {
+			continue
+		}
+
+		ids = append(ids, schedule.Id)
+		items = append(items, map[string]interface{}{
+			"id":             schedule.Id,
+			"environment_id": schedule.EnvironmentId,
+			"interval":       schedule.Interval,
+			"note":           schedule.Note,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("ids", ids)
+	d.Set("schedules", items)
+
+	return nil
+}
+
+func scheduleFiltersTest(schedule *runscope.Schedule, filters *schema.Set) bool {
+	for _, v := range filters.List() {
+		m := v.(map[string]interface{})
+		passed := false
+
+		for _, e := range m["values"].(*schema.Set).List() {
+			switch m["name"].(string) {
+			case "environment_id":
+				passed = passed || schedule.EnvironmentId == e
+			case "interval":
+				passed = passed || schedule.Interval == e
+			case "note":
+				passed = passed || schedule.Note == e
+			}
+		}
+
+		if !passed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeSchedules(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeSchedulesConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.runscope_schedules.all", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.runscope_schedules.hourly", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.runscope_schedules.hourly", "ids.0", "runscope_schedule.hourly", "id"),
+					resource.TestCheckResourceAttrPair("data.runscope_schedules.hourly", "schedules.0.environment_id", "runscope_environment.environment", "id"),
+					resource.TestCheckResourceAttr("data.runscope_schedules.hourly", "schedules.0.interval", "1h"),
+					resource.TestCheckResourceAttr("data.runscope_schedules.hourly", "schedules.0.note", "hourly"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRunscopeSchedulesConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test"
+}
+
+resource "runscope_environment" "environment" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "test-environment"
+}
+
+resource "runscope_schedule" "hourly" {
+  bucket_id      = runscope_bucket.bucket.id
+  test_id        = runscope_test.test.id
+  environment_id = runscope_environment.environment.id
+  interval       = "1h"
+  note           = "hourly"
+}
+
+resource "runscope_schedule" "daily" {
+  bucket_id      = runscope_bucket.bucket.id
+  test_id        = runscope_test.test.id
+  environment_id = runscope_environment.environment.id
+  interval       = "1d"
+}
+
+data "runscope_schedules" "all" {
+  bucket_id  = runscope_bucket.bucket.id
+  test_id    = runscope_test.test.id
+  depends_on = [runscope_schedule.hourly, runscope_schedule.daily]
+}
+
+data "runscope_schedules" "hourly" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  filter {
+    name   = "environment_id"
+    values = [runscope_environment.environment.id]
+  }
+
+  filter {
+    name   = "interval"
+    values = ["1h"]
+  }
+
+  depends_on = [runscope_schedule.hourly, runscope_schedule.daily]
+}
+`
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func dataSourceRunscopeTeam() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeTeamRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeTeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	teams, err := client.Team.List(ctx)
+	if err != nil {
+		return diag.Errorf("Couldn't list teams: %s", err)
+	}
+
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+
+	var found []*runscope.Team
+	for _, team := range teams {
+		if id != "" && team.UUID == id || id == "" && team.Name == name {
+			found = append(found, team)
+		}
+	}
+	if len(found) == 0 {
+		if id != "" {
+			return diag.Errorf("Couldn't find team %s", id)
+		}
+		return diag.Errorf("Couldn't find team %q", name)
+	}
+	if len(found) > 1 {
+		return diag.Errorf("Found %d teams named %q, use id to select one", len(found), name)
+	}
+
+	d.SetId(found[0].UUID)
+	d.Set("name", found[0].Name)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRunscopeTeamMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeTeamMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"team_uuid": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"name", "email"}, false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeTeamMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	filters, filtersOk := d.GetOk("filter")
+
+	members, err := client.Team.ListMembers(ctx, &runscope.TeamMemberListOpts{TeamId: d.Get("team_uuid").(string)})
+	if err != nil {
+		return diag.Errorf("Couldn't list team members: %s", err)
+	}
+
+	ids := []string{}
+	items := []map[string]interface{}{}
+	for _, member := range members {
+		if filtersOk && !teamMemberFiltersTest(member, filters.(*schema.Set)) {
+			continue
+		}
+
+		ids = append(ids, member.Id)
+		items = append(items, map[string]interface{}{
+			"id":    member.Id,
+			"name":  member.Name,
+			"email": member.Email,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("ids", ids)
+	d.Set("members", items)
+
+	return nil
+}
+
+func teamMemberFiltersTest(member *runscope.TeamMember, filters *schema.Set) bool {
+	for _, v := range filters.List() {
+		m := v.(map[string]interface{})
+		passed := false
+
+		for _, e := range m["values"].(*schema.Set).List() {
+			switch m["name"].(string) {
+			case "name":
+				passed = passed || member.Name == e
+			case "email":
+				passed = passed || member.Email == e
+			}
+		}
+
+		if !passed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeTeamMembers(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeTeamMembersConfig, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.runscope_team_members.all", "ids.0"),
+					resource.TestCheckResourceAttrSet("data.runscope_team_members.all", "members.0.id"),
+					resource.TestCheckResourceAttrSet("data.runscope_team_members.all", "members.0.name"),
+					resource.TestCheckResourceAttrSet("data.runscope_team_members.all", "members.0.email"),
+					resource.TestCheckResourceAttr("data.runscope_team_members.by_email", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.runscope_team_members.by_email", "ids.0", "data.runscope_team_members.all", "ids.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRunscopeTeamMembersConfig = `
+data "runscope_team_members" "all" {
+  team_uuid = "%s"
+}
+
+data "runscope_team_members" "by_email" {
+  team_uuid = data.runscope_team_members.all.team_uuid
+
+  filter {
+    name   = "email"
+    values = [data.runscope_team_members.all.members[0].email]
+  }
+}
+`
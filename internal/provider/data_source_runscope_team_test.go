@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeTeam(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeTeamConfig, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.runscope_team.by_id", "id", teamId),
+					resource.TestCheckResourceAttrSet("data.runscope_team.by_id", "name"),
+					resource.TestCheckResourceAttr("data.runscope_team.by_name", "id", teamId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceRunscopeTeam_not_found(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceRunscopeTeamNotFoundConfig,
+				ExpectError: regexp.MustCompile(`Couldn't find team "missing"`),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRunscopeTeamConfig = `
+data "runscope_team" "by_id" {
+  id = "%s"
+}
+
+data "runscope_team" "by_name" {
+  name = data.runscope_team.by_id.name
+}
+`
+
+const testAccDataSourceRunscopeTeamNotFoundConfig = `
+data "runscope_team" "missing" {
+  name = "missing"
+}
+`
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func dataSourceRunscopeTest() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeTestRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"last_run": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trigger_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"step_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	bucketId := d.Get("bucket_id").(string)
+	id := d.Get("id").(string)
+	if name, ok := d.GetOk("name"); ok && id == "" {
+		tests, err := client.Test.ListAll(ctx, runscope.TestListOpts{BucketId: bucketId})
+		if err != nil {
+			return diag.Errorf("Couldn't list tests: %s", err)
+		}
+
+		var found []string
+		for _, test := range tests {
+			if test.Name == name {
+				found = append(found, test.Id)
+			}
+		}
+		if len(found) == 0 {
+			return diag.Errorf("Couldn't find test %q in bucket %s", name, bucketId)
+		}
+		if len(found) > 1 {
+			return diag.Errorf("Found %d tests named %q in bucket %s, use id to select one", len(found), name, bucketId)
+		}
+		id = found[0]
+	}
+
+	test, err := client.Test.Get(ctx, runscope.TestGetOpts{BucketId: bucketId, Id: id})
+	if err != nil {
+		return diag.Errorf("Couldn't read test: %s", err)
+	}
+
+	stepIds := make([]string, len(test.Steps))
+	for i, step := range test.Steps {
+		stepIds[i] = step.Id
+	}
+
+	d.SetId(test.Id)
+	d.Set("name", test.Name)
+	d.Set("description", test.Description)
+	d.Set("default_environment_id", test.DefaultEnvironmentId)
+	d.Set("created_at", flattenTime(test.CreatedAt))
+	d.Set("created_by", flattenCreatedBy(&test.CreatedBy))
+	d.Set("last_run", flattenTime(test.LastRun))
+	d.Set("trigger_url", test.TriggerURL)
+	d.Set("step_ids", stepIds)
+
+	return nil
+}
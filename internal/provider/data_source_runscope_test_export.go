@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func dataSourceRunscopeTestExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeTestExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"test_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeTestExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := runscope.TestExportOpts{
+		BucketId: d.Get("bucket_id").(string),
+		Id:       d.Get("test_id").(string),
+	}
+	document, err := client.Test.Export(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Couldn't export test: %s", err)
+	}
+
+	d.SetId(opts.Id)
+	d.Set("json", string(document))
+
+	return nil
+}
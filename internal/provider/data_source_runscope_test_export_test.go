@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeTestExport(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeTestExportConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.runscope_test_export.test", "id", "runscope_test.test", "id"),
+					resource.TestMatchResourceAttr("data.runscope_test_export.test", "json", regexp.MustCompile(`"name": "runscope exported test"`)),
+					resource.TestMatchResourceAttr("data.runscope_test_export.test", "json", regexp.MustCompile(`"url": "https://example.com/status"`)),
+					resource.TestMatchResourceAttr("data.runscope_test_export.test", "json", regexp.MustCompile(`"environments": \[`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRunscopeTestExportConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope exported test"
+
+  manage_steps = true
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com/status"
+  }
+}
+
+data "runscope_test_export" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+}
+`
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRunscopeTestResults() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeTestResultsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"test_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"test_run_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"started_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finished_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"test_run_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"requests_executed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"assertions_defined": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"assertions_passed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"assertions_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"scripts_defined": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"scripts_passed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"scripts_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"variables_defined": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"variables_passed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"variables_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeTestResultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := &runscope.ResultListOpts{
+		BucketId: d.Get("bucket_id").(string),
+		TestId:   d.Get("test_id").(string),
+		Count:    d.Get("limit").(int),
+	}
+	if v, ok := d.GetOk("since"); ok {
+		opts.Since, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk("before"); ok {
+		opts.Before, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	results, err := client.Result.List(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Couldn't list test results: %s", err)
+	}
+
+	items := make([]map[string]interface{}, len(results))
+	for i, result := range results {
+		items[i] = map[string]interface{}{
+			"test_run_id":        result.TestRunId,
+			"result":             result.Result,
+			"environment_id":     result.EnvironmentId,
+			"environment_name":   result.EnvironmentName,
+			"region":             result.Region,
+			"started_at":         flattenTime(result.StartedAt),
+			"finished_at":        flattenTime(result.FinishedAt),
+			"test_run_url":       result.TestRunURL,
+			"requests_executed":  result.RequestsExecuted,
+			"assertions_defined": result.AssertionsDefined,
+			"assertions_passed":  result.AssertionsPassed,
+			"assertions_failed":  result.AssertionsFailed,
+			"scripts_defined":    result.ScriptsDefined,
+			"scripts_passed":     result.ScriptsPassed,
+			"scripts_failed":     result.ScriptsFailed,
+			"variables_defined":  result.VariablesDefined,
+			"variables_passed":   result.VariablesPassed,
+			"variables_failed":   result.VariablesFailed,
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("results", items)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeTestResults(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeTestResultsConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "results.#", "1"),
+					resource.TestCheckResourceAttrPair("data.runscope_test_results.test", "results.0.test_run_id", "runscope_test_run.test", "id"),
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "results.0.result", "pass"),
+					resource.TestCheckResourceAttrPair("data.runscope_test_results.test", "results.0.environment_id", "runscope_test.test", "default_environment_id"),
+					resource.TestCheckResourceAttrSet("data.runscope_test_results.test", "results.0.started_at"),
+					resource.TestCheckResourceAttrSet("data.runscope_test_results.test", "results.0.finished_at"),
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "results.0.requests_executed", "1"),
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "results.0.assertions_defined", "1"),
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "results.0.assertions_passed", "1"),
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "results.0.assertions_failed", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRunscopeTestResultsConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test results"
+
+  manage_steps = true
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com"
+
+    assertion {
+      source     = "response_status"
+      comparison = "equal_number"
+      value      = "200"
+    }
+  }
+}
+
+resource "runscope_test_run" "test" {
+  trigger_url = runscope_test.test.trigger_url
+}
+
+data "runscope_test_results" "test" {
+  bucket_id  = runscope_bucket.bucket.id
+  test_id    = runscope_test.test.id
+  depends_on = [runscope_test_run.test]
+}
+`
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeTest(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeTestConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.runscope_test.by_id", "name", "runscope_test.test", "name"),
+					resource.TestCheckResourceAttrPair("data.runscope_test.by_id", "default_environment_id", "runscope_test.test", "default_environment_id"),
+					resource.TestCheckResourceAttrPair("data.runscope_test.by_id", "trigger_url", "runscope_test.test", "trigger_url"),
+					resource.TestCheckResourceAttr("data.runscope_test.by_id", "step_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("data.runscope_test.by_id", "step_ids.0", "runscope_test.test", "step.0.id"),
+					resource.TestCheckResourceAttrPair("data.runscope_test.by_id", "step_ids.1", "runscope_test.test", "step.1.id"),
+					resource.TestCheckResourceAttr("data.runscope_test.by_id", "created_by.#", "1"),
+					resource.TestCheckResourceAttr("data.runscope_test.by_id", "last_run", ""),
+					resource.TestCheckResourceAttrPair("data.runscope_test.by_name", "id", "runscope_test.test", "id"),
+					resource.TestCheckResourceAttr("data.runscope_test.by_name", "description", "looked up test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceRunscopeTest_not_found(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccDataSourceRunscopeTestNotFoundConfig, bucketName, teamId),
+				ExpectError: regexp.MustCompile(`Couldn't find test "missing"`),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRunscopeTestConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "runscope looked up test"
+  description = "looked up test"
+
+  manage_steps = true
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com/a"
+  }
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com/b"
+  }
+}
+
+data "runscope_test" "by_id" {
+  bucket_id = runscope_bucket.bucket.id
+  id        = runscope_test.test.id
+}
+
+data "runscope_test" "by_name" {
+  bucket_id  = runscope_bucket.bucket.id
+  name       = "runscope looked up test"
+  depends_on = [runscope_test.test]
+}
+`
+
+const testAccDataSourceRunscopeTestNotFoundConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+data "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "missing"
+}
+`
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRunscopeTests() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeTestsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"name", "description", "created_by"}, false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tests": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trigger_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeTestsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	filters, filtersOk := d.GetOk("filter")
+
+	tests, err := client.Test.ListAll(ctx, runscope.TestListOpts{BucketId: d.Get("bucket_id").(string)})
+	if err != nil {
+		return diag.Errorf("Couldn't list tests: %s", err)
+	}
+
+	ids := []string{}
+	items := []map[string]interface{}{}
+	for _, test := range tests {
+		if filtersOk && !testFiltersTest(test, filters.(*schema.Set)) {
+			continue
+		}
+
+		ids = append(ids, test.Id)
+		items = append(items, map[string]interface{}{
+			"id":          test.Id,
+			"name":        test.Name,
+			"trigger_url": test.TriggerURL,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("ids", ids)
+	d.Set("tests", items)
+
+	return nil
+}
+
+// testFiltersTest checks the test matches all filters. Filter by created_by
+// matches ID, name or email of the user who created the test.
+func testFiltersTest(test *runscope.Test, filters *schema.Set) bool {
+	for _, v := range filters.List() {
+		m := v.(map[string]interface{})
+		passed := false
+
+		for _, e := range m["values"].(*schema.Set).List() {
+			switch m["name"].(string) {
+			case "name":
+				passed = passed || test.Name == e
+			case "description":
+				passed = passed || test.Description == e
+			case "created_by":
+				passed = passed || test.CreatedBy.Id == e || test.CreatedBy.Name == e || test.CreatedBy.Email == e
+			}
+		}
+
+		if !passed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeTests(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeTestsConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.runscope_tests.all", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.runscope_tests.all", "tests.#", "2"),
+					resource.TestCheckResourceAttr("data.runscope_tests.filtered", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.runscope_tests.filtered", "ids.0", "runscope_test.orders", "id"),
+					resource.TestCheckResourceAttr("data.runscope_tests.filtered", "tests.0.name", "orders"),
+					resource.TestCheckResourceAttrPair("data.runscope_tests.filtered", "tests.0.trigger_url", "runscope_test.orders", "trigger_url"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRunscopeTestsConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "users" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "users"
+  description = "checks users"
+}
+
+resource "runscope_test" "orders" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "orders"
+  description = "checks orders"
+}
+
+data "runscope_tests" "all" {
+  bucket_id  = runscope_bucket.bucket.id
+  depends_on = [runscope_test.users, runscope_test.orders]
+}
+
+data "runscope_tests" "filtered" {
+  bucket_id = runscope_bucket.bucket.id
+
+  filter {
+    name   = "name"
+    values = ["orders", "payments"]
+  }
+
+  filter {
+    name   = "description"
+    values = [runscope_test.orders.description]
+  }
+
+  depends_on = [runscope_test.users]
+}
+`
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"sync"
+)
+
+// mutexKV is a set of mutexes identified by string keys, used to serialize
+// changes of an entity which are made by several resources.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: map[string]*sync.Mutex{},
+	}
+}
+
+// Lock locks the mutex of the key, creating it on first use.
+func (m *mutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+// Unlock unlocks the mutex of the key.
+func (m *mutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
+
+// testStepsMutexKV serializes changes of steps of a test. The API creates
+// steps at the end of the test and responds with all its steps, so
+// concurrent changes mix up order of steps and IDs of created ones.
+var testStepsMutexKV = newMutexKV()
+
+// lockTestSteps locks steps of the test and returns the function
+// which unlocks them.
+func lockTestSteps(bucketId, testId string) func() {
+	key := bucketId + "/" + testId
+	testStepsMutexKV.Lock(key)
+	return func() {
+		testStepsMutexKV.Unlock(key)
+	}
+}
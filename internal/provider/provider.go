@@ -2,9 +2,14 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"log"
+	"time"
 )
 
 // Provider returns a terraform.ResourceProvider.
@@ -24,22 +29,70 @@ func Provider() *schema.Provider {
 				Description: "A runscope api url i.e. https://api.runscope.com.",
 				Default:     "https://api.runscope.com",
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      runscope.DefaultRetryMax,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of retries of rate-limited or failed requests.",
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(runscope.DefaultRetryWaitMin / time.Second),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Minimum time in seconds to wait before retrying a request.",
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(runscope.DefaultRetryWaitMax / time.Second),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum time in seconds to wait before retrying a request.",
+			},
+			"rate_limit": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("RUNSCOPE_RATE_LIMIT", 0.0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Maximum number of requests per second sent to the API. Zero means unlimited.",
+			},
+			"rate_limit_burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("RUNSCOPE_RATE_LIMIT_BURST", 1),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of requests sent at once when rate_limit is set.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"runscope_account":       dataSourceRunscopeAccount(),
 			"runscope_integration":   dataSourceRunscopeIntegration(),
 			"runscope_integrations":  dataSourceRunscopeIntegrations(),
 			"runscope_bucket":        dataSourceRunscopeBucket(),
 			"runscope_buckets":       dataSourceRunscopeBuckets(),
+			"runscope_environment":   dataSourceRunscopeEnvironment(),
+			"runscope_environments":  dataSourceRunscopeEnvironments(),
 			"runscope_remote_agents": dataSourceRunscopeRemoteAgents(),
+			"runscope_schedules":     dataSourceRunscopeSchedules(),
+			"runscope_team":          dataSourceRunscopeTeam(),
+			"runscope_team_members":  dataSourceRunscopeTeamMembers(),
+			"runscope_test":          dataSourceRunscopeTest(),
+			"runscope_test_export":   dataSourceRunscopeTestExport(),
+			"runscope_test_results":  dataSourceRunscopeTestResults(),
+			"runscope_tests":         dataSourceRunscopeTests(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"runscope_bucket":      resourceRunscopeBucket(),
+			"runscope_bucket_run":  resourceRunscopeBucketRun(),
 			"runscope_test":        resourceRunscopeTest(),
 			"runscope_environment": resourceRunscopeEnvironment(),
 			"runscope_schedule":    resourceRunscopeSchedule(),
 			"runscope_step":        resourceRunscopeStep(),
+			"runscope_test_import": resourceRunscopeTestImport(),
+			"runscope_test_run":    resourceRunscopeTestRun(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
@@ -50,20 +103,49 @@ type providerConfig struct {
 	client *runscope.Client
 }
 
-func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	token := d.Get("access_token").(string)
 	endpoint := d.Get("api_url").(string)
 
-	client := runscope.NewClient(runscope.WithToken(token), runscope.WithEndpoint(endpoint))
+	retryWaitMin := time.Duration(d.Get("retry_wait_min").(int)) * time.Second
+	retryWaitMax := time.Duration(d.Get("retry_wait_max").(int)) * time.Second
+	if retryWaitMax < retryWaitMin {
+		return nil, diag.Errorf("retry_wait_max should be greater or equal to retry_wait_min")
+	}
+
+	client := runscope.NewClient(
+		runscope.WithToken(token),
+		runscope.WithEndpoint(endpoint),
+		runscope.WithRetryMax(d.Get("max_retries").(int)),
+		runscope.WithRetryWait(retryWaitMin, retryWaitMax),
+		runscope.WithRateLimit(d.Get("rate_limit").(float64), d.Get("rate_limit_burst").(int)),
+	)
 
-	return &providerConfig{
+	config := &providerConfig{
 		client: client,
-	}, nil
+	}
+
+	// Check the token early, so it isn't reported as failure of the first resource.
+	// Other errors may be transient, they don't fail plans which don't need the API.
+	if _, err := client.Account.Get(ctx); err != nil {
+		if errors.Is(err, runscope.ErrUnauthorized) || errors.Is(err, runscope.ErrForbidden) {
+			return nil, diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Invalid access_token",
+				Detail:   fmt.Sprintf("Runscope API rejected the access token: %s", err),
+			}}
+		}
+		log.Printf("[WARN] Couldn't read account of access_token: %s", err)
+		return config, diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Couldn't check access_token",
+			Detail:   fmt.Sprintf("Couldn't read account of access_token: %s", err),
+		}}
+	}
+
+	return config, nil
 }
 
 func isNotFound(err error) bool {
-	if runscopeErr, ok := err.(runscope.Error); ok {
-		return runscopeErr.Status() == 404
-	}
-	return false
+	return errors.Is(err, runscope.ErrNotFound)
 }
@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	runscopeschema "github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscopetest"
 	"os"
 	"testing"
 
@@ -30,6 +33,21 @@ func init() {
 }
 
 func TestMain(m *testing.M) {
+	// Run acceptance tests offline against the in-memory fake API.
+	if os.Getenv("RUNSCOPE_FAKE_API") != "" {
+		server := runscopetest.NewServer()
+		os.Setenv("RUNSCOPE_API_URL", server.URL)
+		if os.Getenv("RUNSCOPE_ACCESS_TOKEN") == "" {
+			os.Setenv("RUNSCOPE_ACCESS_TOKEN", "fake-token")
+		}
+		if os.Getenv("RUNSCOPE_TEAM_ID") == "" {
+			os.Setenv("RUNSCOPE_TEAM_ID", "c8ffd67b-c281-45d3-9735-3f40ee567a02")
+		}
+		teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+		server.AddTeam(runscopeschema.AccountTeam{Id: teamId, Name: "Home"})
+		server.AddTeamMember(teamId, runscopeschema.TeamMember{Id: "4ee15ecc-7fe1-43cb-aa12-ef50420f2cf9", Name: "Fake User", Email: "fake@example.com"})
+	}
+
 	resource.TestMain(m)
 }
 
@@ -40,6 +58,28 @@ func TestProvider(t *testing.T) {
 
 }
 
+func TestProviderConfigure(t *testing.T) {
+	server := runscopetest.NewServer()
+	defer server.Close()
+	server.Token = "secret"
+
+	for token, summary := range map[string]string{"secret": "", "invalid": "Invalid access_token"} {
+		raw := map[string]interface{}{"access_token": token, "api_url": server.URL, "max_retries": 0}
+		diags := Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+		if summary == "" && diags.HasError() || summary != "" && (!diags.HasError() || diags[0].Summary != summary) {
+			t.Errorf("token %s: unexpected diagnostics %+v", token, diags)
+		}
+	}
+
+	// Unavailable API is reported as a warning, which Configure doesn't return.
+	server.Close()
+	raw := map[string]interface{}{"access_token": "secret", "api_url": server.URL, "max_retries": 0}
+	config, diags := providerConfigure(context.Background(), schema.TestResourceDataRaw(t, Provider().Schema, raw))
+	if config == nil || len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("unavailable API: unexpected diagnostics %+v", diags)
+	}
+}
+
 func TestProviderImpl(t *testing.T) {
 	var _ = Provider()
 }
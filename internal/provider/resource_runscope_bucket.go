@@ -12,6 +12,7 @@ func resourceRunscopeBucket() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceBucketCreate,
 		ReadContext:   resourceBucketRead,
+		UpdateContext: resourceBucketUpdate,
 		DeleteContext: resourceBucketDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceBucketImport,
@@ -20,7 +21,6 @@ func resourceRunscopeBucket() *schema.Resource {
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"team_uuid": {
 				Type:     schema.TypeString,
@@ -38,6 +38,7 @@ func resourceRunscopeBucket() *schema.Resource {
 			},
 			"verify_ssl": {
 				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
 			"trigger_url": {
@@ -63,6 +64,11 @@ func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(bucket.Key)
 
+	// Buckets are created verifying SSL, it can be disabled only by update.
+	if verifySSL, ok := d.GetOkExists("verify_ssl"); ok && verifySSL.(bool) != bucket.VerifySSL {
+		return resourceBucketUpdate(ctx, d, meta)
+	}
+
 	return resourceBucketRead(ctx, d, meta)
 }
 
@@ -91,6 +97,23 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	// API changes only name and verify_ssl, keeping other fields of the bucket.
+	opts := &runscope.BucketUpdateOpts{
+		Name:      d.Get("name").(string),
+		VerifySSL: d.Get("verify_ssl").(bool),
+	}
+	opts.Key = d.Id()
+
+	if _, err := client.Bucket.Update(ctx, opts); err != nil {
+		return diag.Errorf("Couldn't update bucket: %s", err)
+	}
+
+	return resourceBucketRead(ctx, d, meta)
+}
+
 func resourceBucketImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	key := d.Id()
 
@@ -114,7 +137,7 @@ func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	opts := &runscope.BucketDeleteOpts{}
 	opts.Key = d.Id()
 
-	if err := client.Bucket.Delete(ctx, opts); err != nil {
+	if err := client.Bucket.Delete(ctx, opts); err != nil && !isNotFound(err) {
 		return diag.Errorf("Error deleting bucket: %s", err)
 	}
 
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRunscopeBucketRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketRunCreate,
+		ReadContext:   resourceBucketRunRead,
+		DeleteContext: resourceBucketRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assertions_passed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"assertions_failed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"run": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     testRunResource(),
+			},
+		},
+	}
+}
+
+func resourceBucketRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := &runscope.BucketTriggerOpts{
+		EnvironmentId: d.Get("environment_id").(string),
+		Variables:     map[string]string{},
+	}
+	opts.Key = d.Get("bucket_id").(string)
+	for name, value := range d.Get("variables").(map[string]interface{}) {
+		opts.Variables[name] = value.(string)
+	}
+
+	runs, err := client.Bucket.Trigger(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Couldn't trigger bucket run: %s", err)
+	}
+	if len(runs) == 0 {
+		return diag.Errorf("Couldn't trigger bucket run: no tests to run")
+	}
+
+	results, err := waitForTestRuns(ctx, client, runs, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("Couldn't wait for bucket run: %s", err)
+	}
+
+	d.SetId(runs[0].TestRunId)
+
+	if failed := flattenTestRunResults(d, runs, results); len(failed) > 0 {
+		return diag.Errorf("%d of %d test runs failed: %s", len(failed), len(runs), strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+func resourceBucketRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceBucketRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBucketRun_basic(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccBucketRunConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("runscope_bucket_run.bucket", "result", "pass"),
+					resource.TestCheckResourceAttr("runscope_bucket_run.bucket", "assertions_passed", "2"),
+					resource.TestCheckResourceAttr("runscope_bucket_run.bucket", "assertions_failed", "0"),
+					resource.TestCheckResourceAttr("runscope_bucket_run.bucket", "run.#", "2"),
+					resource.TestCheckResourceAttr("runscope_bucket_run.bucket", "run.0.result", "pass"),
+					resource.TestCheckResourceAttrSet("runscope_bucket_run.bucket", "run.0.test_run_url"),
+					resource.TestCheckResourceAttr("runscope_bucket_run.bucket", "run.1.result", "pass"),
+					resource.TestCheckResourceAttrSet("runscope_bucket_run.bucket", "run.1.test_run_url"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBucketRunConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "a" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope bucket run a"
+
+  manage_steps = true
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com/"
+
+    assertion {
+      source     = "response_status"
+      comparison = "equal_number"
+      value      = "200"
+    }
+  }
+}
+
+resource "runscope_test" "b" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope bucket run b"
+
+  manage_steps = true
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com/"
+
+    assertion {
+      source     = "response_status"
+      comparison = "equal_number"
+      value      = "200"
+    }
+  }
+}
+
+resource "runscope_bucket_run" "bucket" {
+  bucket_id  = runscope_bucket.bucket.id
+  depends_on = [runscope_test.a, runscope_test.b]
+}
+`
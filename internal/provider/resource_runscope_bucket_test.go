@@ -49,6 +49,53 @@ func TestAccBucket_basic(t *testing.T) {
 	})
 }
 
+func TestAccBucket_update(t *testing.T) {
+	var bucket, updated runscope.Bucket
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccRunscopeBucketBasicConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists("runscope_bucket.bucket", &bucket),
+					resource.TestCheckResourceAttr("runscope_bucket.bucket", "verify_ssl", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccRunscopeBucketUpdateConfig, bucketName+"-renamed", teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists("runscope_bucket.bucket", &updated),
+					resource.TestCheckResourceAttr("runscope_bucket.bucket", "name", bucketName+"-renamed"),
+					resource.TestCheckResourceAttr("runscope_bucket.bucket", "verify_ssl", "false"),
+					func(s *terraform.State) error {
+						if updated.Key != bucket.Key {
+							return fmt.Errorf("Expected bucket %s to be updated in place, got %s", bucket.Key, updated.Key)
+						}
+						// Update sends name and verify_ssl only, the rest must be kept.
+						if updated.Default != bucket.Default || updated.AuthToken != bucket.AuthToken ||
+							updated.TriggerURL != bucket.TriggerURL || updated.Team != bucket.Team {
+							return fmt.Errorf("Expected update to keep other fields of %+v, got %+v", bucket, updated)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Unset verify_ssl keeps the value of the bucket.
+				Config: fmt.Sprintf(testAccRunscopeBucketBasicConfig, bucketName+"-renamed", teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("runscope_bucket.bucket", "verify_ssl", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBucketDestroy(s *terraform.State) error {
 	ctx := context.Background()
 	client := testAccProvider.Meta().(*providerConfig).client
@@ -99,6 +146,13 @@ resource "runscope_bucket" "bucket" {
   team_uuid = "%s"
 }`
 
+const testAccRunscopeBucketUpdateConfig = `
+resource "runscope_bucket" "bucket" {
+  name       = "%s"
+  team_uuid  = "%s"
+  verify_ssl = false
+}`
+
 func testAccSweepBuckets(_ string) error {
 	ctx := context.Background()
 
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"strings"
 )
 
 func resourceRunscopeEnvironment() *schema.Resource {
@@ -14,6 +16,9 @@ func resourceRunscopeEnvironment() *schema.Resource {
 		ReadContext:   resourceEnvironmentRead,
 		UpdateContext: resourceEnvironmentUpdate,
 		DeleteContext: resourceEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEnvironmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket_id": {
@@ -207,6 +212,69 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	return nil
 }
 
+// resourceEnvironmentImport imports shared environment by bucket_id/environment_id
+// or bucket_id:name, and test environment by bucket_id/test_id/environment_id
+// or bucket_id/test_id:name.
+func resourceEnvironmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerConfig).client
+
+	const format = "environment ID for import should be in format bucket_id/environment_id, " +
+		"bucket_id/test_id/environment_id, bucket_id:name or bucket_id/test_id:name"
+
+	id := d.Id()
+	var name string
+	if parts := strings.SplitN(id, ":", 2); len(parts) == 2 {
+		id, name = parts[0], parts[1]
+	}
+
+	parts := strings.Split(id, "/")
+	uriOpts := runscope.EnvironmentUriOpts{BucketId: parts[0]}
+	switch {
+	case name == "" && len(parts) == 2:
+		id = parts[1]
+	case name == "" && len(parts) == 3:
+		uriOpts.TestId = parts[1]
+		id = parts[2]
+	case name != "" && len(parts) == 1:
+	case name != "" && len(parts) == 2:
+		uriOpts.TestId = parts[1]
+	default:
+		return nil, fmt.Errorf(format)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf(format)
+		}
+	}
+
+	if name != "" {
+		envs, err := client.Environment.List(ctx, &runscope.EnvironmentListOpts{EnvironmentUriOpts: uriOpts})
+		if err != nil {
+			return nil, fmt.Errorf("Couldn't list environments: %s", err)
+		}
+
+		var found []string
+		for _, env := range envs {
+			if env.Name == name {
+				found = append(found, env.Id)
+			}
+		}
+		if len(found) == 0 {
+			return nil, fmt.Errorf("Couldn't find environment %q", name)
+		}
+		if len(found) > 1 {
+			return nil, fmt.Errorf("Found %d environments named %q, use id to import one", len(found), name)
+		}
+		id = found[0]
+	}
+
+	d.Set("bucket_id", uriOpts.BucketId)
+	d.Set("test_id", uriOpts.TestId)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*providerConfig).client
 
@@ -231,7 +299,7 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta
 	opts := runscope.EnvironmentDeleteOpts{}
 	expandEnvironmentGetOpts(d, &opts.EnvironmentGetOpts)
 
-	if err := client.Environment.Delete(ctx, &opts); err != nil {
+	if err := client.Environment.Delete(ctx, &opts); err != nil && !isNotFound(err) {
 		return diag.Errorf("Error deleting environment: %s", err)
 	}
 
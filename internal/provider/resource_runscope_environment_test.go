@@ -21,6 +21,8 @@ func TestAccEnvironment_create_default_shared_environment(t *testing.T) {
 		CheckDestroy:      testAccCheckEnvironmentDestroy,
 		Steps: []resource.TestStep{
 			testAccEnvironmentDefaultConfigStep(testAccEnvironmentSharedDefaultConfig, bucketId, teamId, &environment),
+			testAccEnvironmentImportStep("%[1]s/%[3]s"),
+			testAccEnvironmentImportStep("%[1]s:environment"),
 		},
 	})
 }
@@ -84,6 +86,8 @@ func TestAccEnvironment_create_default_test_environment(t *testing.T) {
 		CheckDestroy:      testAccCheckEnvironmentDestroy,
 		Steps: []resource.TestStep{
 			testAccEnvironmentDefaultConfigStep(testAccEnvironmentTestDefaultConfig, bucketId, teamId, &environment),
+			testAccEnvironmentImportStep("%[1]s/%[2]s/%[3]s"),
+			testAccEnvironmentImportStep("%[1]s/%[2]s:environment"),
 		},
 	})
 }
@@ -276,6 +280,23 @@ func testAccEnvironmentCustomConfigStep(config, bucketId, teamId, recipientId, r
 	}
 }
 
+// testAccEnvironmentImportStep imports runscope_environment.environment by ID built
+// from format, with bucket_id, test_id and environment ID as arguments.
+func testAccEnvironmentImportStep(format string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      "runscope_environment.environment",
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateIdFunc: func(s *terraform.State) (string, error) {
+			rs, ok := s.RootModule().Resources["runscope_environment.environment"]
+			if !ok {
+				return "", fmt.Errorf("not found runscope_environment.environment")
+			}
+			return fmt.Sprintf(format, rs.Primary.Attributes["bucket_id"], rs.Primary.Attributes["test_id"], rs.Primary.ID), nil
+		},
+	}
+}
+
 const testAccEnvironmentClientCertficate = `-----BEGIN CERTIFICATE-----
 MIIDDTCCAfWgAwIBAgIUd8JoBoWhPUHSqxgMvDqTgBFmHTswDQYJKoZIhvcNAQEL
 BQAwFjEUMBIGA1UECwwLZXhhbXBsZS5vcmcwHhcNMjEwNDExMjEyMzE5WhcNMzEw
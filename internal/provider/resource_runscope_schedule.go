@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
@@ -16,6 +17,20 @@ func resourceRunscopeSchedule() *schema.Resource {
 		ReadContext:   resourceScheduleRead,
 		UpdateContext: resourceScheduleUpdate,
 		DeleteContext: resourceScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+					return nil, fmt.Errorf("schedule ID for import should be in format bucket_id/test_id/schedule_id")
+				}
+
+				d.Set("bucket_id", parts[0])
+				d.Set("test_id", parts[1])
+				d.SetId(parts[2])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket_id": {
@@ -125,8 +140,8 @@ func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta in
 	opts := &runscope.ScheduleDeleteOpts{}
 	expandScheduleGetOpts(d, &opts.ScheduleGetOpts)
 
-	if err := client.Schedule.Delete(ctx, opts); err != nil {
-		return diag.Errorf("Error deleting test: %s", err)
+	if err := client.Schedule.Delete(ctx, opts); err != nil && !isNotFound(err) {
+		return diag.Errorf("Error deleting schedule: %s", err)
 	}
 
 	return nil
@@ -54,6 +54,18 @@ func TestAccSchedule_create_custom(t *testing.T) {
 					resource.TestCheckResourceAttrSet("runscope_schedule.daily", "exported_at"),
 				),
 			},
+			{
+				ResourceName:      "runscope_schedule.daily",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["runscope_schedule.daily"]
+					if !ok {
+						return "", fmt.Errorf("not found runscope_schedule.daily")
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["bucket_id"], rs.Primary.Attributes["test_id"], rs.Primary.ID), nil
+				},
+			},
 		},
 	})
 }
@@ -4,14 +4,32 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"mime"
+	"reflect"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+var stepTypes = []string{"request", "pause", "condition", "subtest", "ghost"}
+
+// stepTypeBlocks maps step types to blocks with their attributes.
+var stepTypeBlocks = map[string]string{
+	"pause":     "pause",
+	"condition": "condition",
+	"subtest":   "subtest",
+	"ghost":     "ghost_inspector",
+}
+
+// stepRequestAttributes are attributes allowed only in request steps.
+var stepRequestAttributes = []string{"method", "url", "header", "auth", "body", "form_parameter", "multipart_form_parameter"}
+
+var stepMultipartTypes = []string{"text", "file"}
+
 var stepSources = []string{"response_status", "response_headers", "response_json", "response_xml", "response_text", "response_time", "response_size"}
 var stepComparisons = []string{
 	"equal",
@@ -31,12 +49,31 @@ var stepComparisons = []string{
 	"is_null",
 }
 
+// stepPropertySources are sources which have properties, e.g. header name
+// or JSON path. Property of response_headers source is required.
+var stepPropertySources = []string{"response_headers", "response_json", "response_xml"}
+
+// stepUnaryComparisons are comparisons which don't take value.
+var stepUnaryComparisons = []string{"empty", "not_empty", "is_a_number", "is_null"}
+
+// stepNumericComparisons are comparisons which take numeric value.
+var stepNumericComparisons = []string{
+	"equal_number",
+	"is_less_than",
+	"is_less_than_or_equal",
+	"is_greater_than",
+	"is_greater_than_or_equal",
+}
+
 func resourceRunscopeStep() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceStepCreate,
 		ReadContext:   resourceStepRead,
 		UpdateContext: resourceStepUpdate,
 		DeleteContext: resourceStepDelete,
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			return validateStep(d, "")
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				parts := strings.Split(d.Id(), "/")
@@ -91,153 +128,340 @@ func resourceRunscopeStep() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
-		Schema: map[string]*schema.Schema{
-			"bucket_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"test_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"step_type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"method": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"url": {
-				Type:     schema.TypeString,
-				Required: true,
+		Schema: resourceStepSchema(),
+	}
+}
+
+func resourceStepSchema() map[string]*schema.Schema {
+	s := stepBaseSchema()
+	s["bucket_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["test_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["step_type"].ForceNew = true
+	s["after_step_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	return s
+}
+
+// stepBaseSchema returns schema of step attributes shared by runscope_step
+// resource and step blocks of runscope_test resource.
+func stepBaseSchema() map[string]*schema.Schema {
+	s := stepRequestSchema()
+	s["step_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(stepTypes, false),
+	}
+	s["pause"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
 			},
-			"variable": {
-				Type: schema.TypeSet,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"property": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"source": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(stepSources, false),
-						},
+		},
+	}
+	conditionStepSchema := stepRequestSchema()
+	conditionStepSchema["method"].Optional = false
+	conditionStepSchema["method"].Required = true
+	conditionStepSchema["url"].Optional = false
+	conditionStepSchema["url"].Required = true
+	s["condition"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"left_value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"comparison": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(stepComparisons, false),
+				},
+				"right_value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"step": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: conditionStepSchema,
 					},
 				},
-				Optional: true,
 			},
-			"assertion": {
-				Type: schema.TypeList,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"source": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(stepSources, false),
-						},
-						"property": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"comparison": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(stepComparisons, false),
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Optional: true,
+		},
+	}
+	s["subtest"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"test_uuid": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"environment_uuid": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"bucket_key": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"param": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
 						},
 					},
 				},
-				Optional: true,
 			},
-			"header": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"header": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
+		},
+	}
+	s["ghost_inspector"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"integration_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"test_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"start_url": {
+					Type:     schema.TypeString,
+					Optional: true,
 				},
 			},
-			"auth": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"username": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"auth_type": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"password": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
+		},
+	}
+	return s
+}
+
+// stepRequestSchema returns schema of attributes of request steps, which are
+// also the only steps allowed in conditions.
+func stepRequestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"method": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"url": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"variable": {
+			Type: schema.TypeSet,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"property": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"source": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(stepSources, false),
 					},
 				},
 			},
-			"body": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"form_parameter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
+			Optional: true,
+		},
+		"assertion": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"source": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(stepSources, false),
+					},
+					"property": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"comparison": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(stepComparisons, false),
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Optional: true,
 					},
 				},
 			},
-			"scripts": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+		},
+		"header": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"header": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
 			},
-			"before_scripts": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"auth": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"username": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"auth_type": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"password": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
 			},
-			"note": {
-				Type:     schema.TypeString,
-				Optional: true,
+		},
+		"body": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: suppressEquivalentJsonBody,
+		},
+		"form_parameter": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
 			},
-			"skipped": {
-				Type:     schema.TypeBool,
-				Optional: true,
+		},
+		"multipart_form_parameter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "text",
+						ValidateFunc: validation.StringInSlice(stepMultipartTypes, false),
+					},
+					"filename": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"content_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
 			},
 		},
+		"scripts": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"before_scripts": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"note": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"skipped": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+}
+
+// suppressEquivalentJsonBody suppresses diff of the step body which differs
+// only in formatting of JSON, when the step has a JSON Content-Type header.
+func suppressEquivalentJsonBody(k, old, new string, d *schema.ResourceData) bool {
+	headers, ok := d.Get(strings.TrimSuffix(k, "body") + "header").(*schema.Set)
+	if !ok {
+		return false
+	}
+
+	for _, h := range headers.List() {
+		header := h.(map[string]interface{})
+		if strings.EqualFold(header["header"].(string), "Content-Type") && isJsonContentType(header["value"].(string)) {
+			return structure.SuppressJsonDiff(k, old, new, d)
+		}
 	}
+	return false
+}
+
+// isJsonContentType reports whether the media type is application/json
+// or has +json suffix, e.g. application/vnd.api+json.
+func isJsonContentType(v string) bool {
+	mediaType, _, err := mime.ParseMediaType(v)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
 }
 
 func resourceStepCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -247,6 +471,8 @@ func resourceStepCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	expandStepUriOpts(d, &opts.StepUriOpts)
 	expandStepBaseOpts(d, &opts.StepBaseOpts)
 
+	defer lockTestSteps(opts.BucketId, opts.TestId)()
+
 	step, err := client.Step.Create(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Couldn't create step: %s", err)
@@ -254,6 +480,12 @@ func resourceStepCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.SetId(step.Id)
 
+	if v, ok := d.GetOk("after_step_id"); ok {
+		if err := moveStepAfter(ctx, client, opts.StepUriOpts, step.Id, v.(string)); err != nil {
+			return diag.Errorf("Couldn't move step: %s", err)
+		}
+	}
+
 	return resourceStepRead(ctx, d, meta)
 }
 
@@ -286,10 +518,23 @@ func resourceStepRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 	d.Set("body", step.Body)
 	d.Set("form_parameter", flattenFormParameters(step.Form))
+	d.Set("multipart_form_parameter", flattenStepMultipartForm(step.MultipartForm))
 	d.Set("scripts", step.Scripts)
 	d.Set("before_scripts", step.BeforeScripts)
 	d.Set("note", step.Note)
 	d.Set("skipped", step.Skipped)
+	d.Set("pause", flattenStepPause(&step.StepBase))
+	d.Set("condition", flattenStepCondition(&step.StepBase))
+	d.Set("subtest", flattenStepSubtest(&step.StepBase))
+	d.Set("ghost_inspector", flattenStepGhostInspector(&step.StepBase))
+
+	if _, ok := d.GetOk("after_step_id"); ok {
+		test, err := client.Test.Get(ctx, runscope.TestGetOpts{BucketId: opts.BucketId, Id: opts.TestId})
+		if err != nil {
+			return diag.Errorf("Couldn't read test: %s", err)
+		}
+		d.Set("after_step_id", previousStepId(test.Steps, step.Id))
+	}
 
 	return nil
 }
@@ -301,11 +546,19 @@ func resourceStepUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	expandStepGetOpts(d, &opts.StepGetOpts)
 	expandStepBaseOpts(d, &opts.StepBaseOpts)
 
+	defer lockTestSteps(opts.BucketId, opts.TestId)()
+
 	_, err := client.Step.Update(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Couldn't create step: %s", err)
 	}
 
+	if v, ok := d.GetOk("after_step_id"); ok && d.HasChange("after_step_id") {
+		if err := moveStepAfter(ctx, client, opts.StepUriOpts, opts.Id, v.(string)); err != nil {
+			return diag.Errorf("Couldn't move step: %s", err)
+		}
+	}
+
 	return resourceStepRead(ctx, d, meta)
 }
 
@@ -315,13 +568,58 @@ func resourceStepDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	opts := &runscope.StepDeleteOpts{}
 	expandStepGetOpts(d, &opts.StepGetOpts)
 
-	if err := client.Step.Delete(ctx, opts); err != nil {
-		return diag.Errorf("Couldn't read step: %s", err)
+	defer lockTestSteps(opts.BucketId, opts.TestId)()
+
+	if err := client.Step.Delete(ctx, opts); err != nil && !isNotFound(err) {
+		return diag.Errorf("Couldn't delete step: %s", err)
 	}
 
 	return nil
 }
 
+// moveStepAfter moves the step of the test to position right after
+// the step afterStepId, keeping order of other steps.
+func moveStepAfter(ctx context.Context, client *runscope.Client, uriOpts runscope.StepUriOpts, stepId, afterStepId string) error {
+	test, err := client.Test.Get(ctx, runscope.TestGetOpts{BucketId: uriOpts.BucketId, Id: uriOpts.TestId})
+	if err != nil {
+		return err
+	}
+
+	if previousStepId(test.Steps, stepId) == afterStepId {
+		return nil
+	}
+
+	opts := &runscope.StepReorderOpts{StepUriOpts: uriOpts}
+	found := false
+	for _, step := range test.Steps {
+		if step.Id == stepId {
+			continue
+		}
+		opts.Ids = append(opts.Ids, step.Id)
+		if step.Id == afterStepId {
+			opts.Ids = append(opts.Ids, stepId)
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("step %s not found in test %s", afterStepId, uriOpts.TestId)
+	}
+
+	_, err = client.Step.Reorder(ctx, opts)
+	return err
+}
+
+// previousStepId returns ID of the step preceding the step stepId,
+// or an empty string if it's the first step.
+func previousStepId(steps []runscope.Step, stepId string) string {
+	for i, step := range steps {
+		if step.Id == stepId && i > 0 {
+			return steps[i-1].Id
+		}
+	}
+	return ""
+}
+
 func expandStepUriOpts(d *schema.ResourceData, opts *runscope.StepUriOpts) {
 	opts.BucketId = d.Get("bucket_id").(string)
 	opts.TestId = d.Get("test_id").(string)
@@ -332,8 +630,43 @@ func expandStepGetOpts(d *schema.ResourceData, opts *runscope.StepGetOpts) {
 	expandStepUriOpts(d, &opts.StepUriOpts)
 }
 
-func expandStepBaseOpts(d *schema.ResourceData, opts *runscope.StepBaseOpts) {
-	opts.StepType = d.Get("step_type").(string)
+// stepAttributes is implemented by *schema.ResourceData and stepBlock,
+// so that runscope_step resource and step blocks of runscope_test
+// are expanded the same way.
+type stepAttributes interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+// stepBlock is a step block of runscope_test resource.
+type stepBlock map[string]interface{}
+
+func (b stepBlock) Get(key string) interface{} {
+	return b[key]
+}
+
+// GetOk returns value of attribute and whether it's set to non-zero value,
+// the same as schema.ResourceData.GetOk does.
+func (b stepBlock) GetOk(key string) (interface{}, bool) {
+	v, ok := b[key]
+	if !ok || v == nil {
+		return v, false
+	}
+	switch value := v.(type) {
+	case []interface{}:
+		return v, len(value) > 0
+	case map[string]interface{}:
+		return v, len(value) > 0
+	case *schema.Set:
+		return v, value.Len() > 0
+	}
+	return v, !reflect.ValueOf(v).IsZero()
+}
+
+func expandStepBaseOpts(d stepAttributes, opts *runscope.StepBaseOpts) {
+	if v, ok := d.GetOk("step_type"); ok {
+		opts.StepType = v.(string)
+	}
 	if v, ok := d.GetOk("method"); ok {
 		opts.Method = v.(string)
 	}
@@ -358,6 +691,9 @@ func expandStepBaseOpts(d *schema.ResourceData, opts *runscope.StepBaseOpts) {
 	if v, ok := d.GetOk("form_parameter"); ok {
 		opts.Form = expandStepForm(v.(*schema.Set).List())
 	}
+	if v, ok := d.GetOk("multipart_form_parameter"); ok {
+		opts.MultipartForm = expandStepMultipartForm(v.([]interface{}))
+	}
 	if v, ok := d.GetOk("scripts"); ok {
 		opts.Scripts = expandStringSlice(v.([]interface{}))
 	}
@@ -370,4 +706,166 @@ func expandStepBaseOpts(d *schema.ResourceData, opts *runscope.StepBaseOpts) {
 	if v, ok := d.GetOk("skipped"); ok {
 		opts.Skipped = v.(bool)
 	}
+	if v, ok := d.GetOk("pause"); ok {
+		pause := v.([]interface{})[0].(map[string]interface{})
+		opts.Duration = pause["duration"].(int)
+	}
+	if v, ok := d.GetOk("condition"); ok {
+		condition := v.([]interface{})[0].(map[string]interface{})
+		opts.LeftValue = condition["left_value"].(string)
+		opts.Comparison = condition["comparison"].(string)
+		opts.RightValue = condition["right_value"].(string)
+		opts.Steps = expandConditionSteps(condition["step"].([]interface{}))
+	}
+	if v, ok := d.GetOk("subtest"); ok {
+		subtest := v.([]interface{})[0].(map[string]interface{})
+		opts.TestUUID = subtest["test_uuid"].(string)
+		opts.EnvironmentUUID = subtest["environment_uuid"].(string)
+		opts.BucketKey = subtest["bucket_key"].(string)
+		opts.Params = expandStepParams(subtest["param"].([]interface{}))
+	}
+	if v, ok := d.GetOk("ghost_inspector"); ok {
+		ghost := v.([]interface{})[0].(map[string]interface{})
+		opts.IntegrationId = ghost["integration_id"].(string)
+		opts.GhostTestId = ghost["test_id"].(string)
+		opts.StartURL = ghost["start_url"].(string)
+	}
+}
+
+// validateStep checks that step has attributes required by its type and
+// has no attributes of other types. Attributes are looked up with prefix,
+// e.g. "step.0." for step blocks of runscope_test.
+func validateStep(d *schema.ResourceDiff, prefix string) error {
+	if !d.NewValueKnown(prefix + "step_type") {
+		return nil
+	}
+	stepType := d.Get(prefix + "step_type").(string)
+
+	isSet := func(key string) bool {
+		_, ok := d.GetOk(prefix + key)
+		return ok || !d.NewValueKnown(prefix+key)
+	}
+
+	for _, t := range stepTypes {
+		block, ok := stepTypeBlocks[t]
+		if !ok {
+			continue
+		}
+		if t == stepType && !isSet(block) {
+			return fmt.Errorf("%s step requires %s block", stepType, block)
+		}
+		if t != stepType && isSet(block) {
+			return fmt.Errorf("%s block is not allowed in %s step", block, stepType)
+		}
+	}
+
+	for _, key := range stepRequestAttributes {
+		if stepType == "request" && (key == "method" || key == "url") && !isSet(key) {
+			return fmt.Errorf("request step requires %s", key)
+		}
+		if stepType != "request" && isSet(key) {
+			return fmt.Errorf("%s is not allowed in %s step", key, stepType)
+		}
+	}
+
+	if err := validateStepVariables(d, prefix); err != nil {
+		return err
+	}
+	if err := validateStepAssertions(d, prefix); err != nil {
+		return err
+	}
+
+	if stepType == "condition" {
+		for i := range d.Get(prefix + "condition.0.step").([]interface{}) {
+			stepPrefix := fmt.Sprintf("%scondition.0.step.%d.", prefix, i)
+			if err := validateStepVariables(d, stepPrefix); err != nil {
+				return fmt.Errorf("condition.0.step.%d: %s", i, err)
+			}
+			if err := validateStepAssertions(d, stepPrefix); err != nil {
+				return fmt.Errorf("condition.0.step.%d: %s", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateStepVariables checks property of each variable against its source.
+func validateStepVariables(d *schema.ResourceDiff, prefix string) error {
+	if !d.NewValueKnown(prefix + "variable") {
+		return nil
+	}
+
+	v, ok := d.Get(prefix + "variable").(*schema.Set)
+	if !ok {
+		return nil
+	}
+	// Variables are a set, so they are referred to by name rather than index.
+	for _, v := range v.List() {
+		variable := v.(map[string]interface{})
+		if err := validateStepProperty(variable["source"].(string), variable["property"].(string)); err != nil {
+			return fmt.Errorf("variable %q: %s", variable["name"], err)
+		}
+	}
+	return nil
+}
+
+// validateStepAssertions checks property and value of each assertion
+// against its source and comparison.
+func validateStepAssertions(d *schema.ResourceDiff, prefix string) error {
+	for i := range d.Get(prefix + "assertion").([]interface{}) {
+		key := fmt.Sprintf("%sassertion.%d.", prefix, i)
+		isKnown := func(attr string) bool {
+			return d.NewValueKnown(key + attr)
+		}
+
+		if isKnown("source") && isKnown("property") {
+			source := d.Get(key + "source").(string)
+			property := d.Get(key + "property").(string)
+			if err := validateStepProperty(source, property); err != nil {
+				return fmt.Errorf("assertion.%d.property: %s", i, err)
+			}
+		}
+
+		if isKnown("comparison") && isKnown("value") {
+			comparison := d.Get(key + "comparison").(string)
+			value := d.Get(key + "value").(string)
+			if err := validateStepAssertionValue(comparison, value); err != nil {
+				return fmt.Errorf("assertion.%d.value: %s", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateStepProperty(source, property string) error {
+	if source == "response_headers" && property == "" {
+		return fmt.Errorf("%s source requires property", source)
+	}
+	if property != "" && !stringInSlice(stepPropertySources, source) {
+		return fmt.Errorf("property is not allowed with %s source", source)
+	}
+	return nil
+}
+
+func validateStepAssertionValue(comparison, value string) error {
+	if stringInSlice(stepUnaryComparisons, comparison) && value != "" {
+		return fmt.Errorf("value is not allowed with %s comparison", comparison)
+	}
+	// Values referring to variables, e.g. {{limit}}, are known on run only.
+	if stringInSlice(stepNumericComparisons, comparison) && !strings.Contains(value, "{{") {
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%s comparison requires numeric value, got %q", comparison, value)
+		}
+	}
+	return nil
+}
+
+func stringInSlice(slice []string, s string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
 }
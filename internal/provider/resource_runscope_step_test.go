@@ -231,6 +231,210 @@ func TestAccStep_multiple(t *testing.T) {
 	})
 }
 
+func TestAccStep_parallel(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStepParallelConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepExists("runscope_step.step.0"),
+					testAccCheckStepExists("runscope_step.step.4"),
+					resource.TestCheckResourceAttr("runscope_step.step.0", "url", "https://example.org/0"),
+					resource.TestCheckResourceAttr("runscope_step.step.1", "url", "https://example.org/1"),
+					resource.TestCheckResourceAttr("runscope_step.step.2", "url", "https://example.org/2"),
+					resource.TestCheckResourceAttr("runscope_step.step.3", "url", "https://example.org/3"),
+					resource.TestCheckResourceAttr("runscope_step.step.4", "url", "https://example.org/4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStep_after_step_id(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStepAfterStepIdConfig, bucketName, teamId, "runscope_step.step_a.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepOrder("runscope_test.test", "runscope_step.step_a", "runscope_step.step_c", "runscope_step.step_b"),
+					resource.TestCheckResourceAttrPair("runscope_step.step_c", "after_step_id", "runscope_step.step_a", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccStepAfterStepIdConfig, bucketName, teamId, "runscope_step.step_b.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepOrder("runscope_test.test", "runscope_step.step_a", "runscope_step.step_b", "runscope_step.step_c"),
+					resource.TestCheckResourceAttrPair("runscope_step.step_c", "after_step_id", "runscope_step.step_b", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStep_json_body(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStepJsonBodyConfig, bucketName, teamId, `jsonencode({ item = "book", count = 1 })`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepExists("runscope_step.step"),
+					resource.TestCheckResourceAttr("runscope_step.step", "body", `{"count":1,"item":"book"}`),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccStepJsonBodyConfig, bucketName, teamId, `<<-EOT
+    {
+      "item": "book",
+      "count": 1
+    }
+  EOT`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccStep_multipart_form(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStepMultipartFormConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepExists("runscope_step.step"),
+					resource.TestCheckResourceAttr("runscope_step.step", "multipart_form_parameter.#", "2"),
+					resource.TestCheckResourceAttr("runscope_step.step", "multipart_form_parameter.0.name", "title"),
+					resource.TestCheckResourceAttr("runscope_step.step", "multipart_form_parameter.0.value", "report"),
+					resource.TestCheckResourceAttr("runscope_step.step", "multipart_form_parameter.0.type", "text"),
+					resource.TestCheckResourceAttr("runscope_step.step", "multipart_form_parameter.1.name", "file"),
+					resource.TestCheckResourceAttr("runscope_step.step", "multipart_form_parameter.1.value", "a,b"),
+					resource.TestCheckResourceAttr("runscope_step.step", "multipart_form_parameter.1.type", "file"),
+					resource.TestCheckResourceAttr("runscope_step.step", "multipart_form_parameter.1.filename", "report.csv"),
+					resource.TestCheckResourceAttr("runscope_step.step", "multipart_form_parameter.1.content_type", "text/csv"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStep_pause(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStepPauseConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepExists("runscope_step.step"),
+					resource.TestCheckResourceAttr("runscope_step.step", "step_type", "pause"),
+					resource.TestCheckResourceAttr("runscope_step.step", "pause.#", "1"),
+					resource.TestCheckResourceAttr("runscope_step.step", "pause.0.duration", "5"),
+					resource.TestCheckResourceAttr("runscope_step.step", "method", ""),
+					resource.TestCheckResourceAttr("runscope_step.step", "url", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStep_condition(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStepConditionConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepExists("runscope_step.step"),
+					resource.TestCheckResourceAttr("runscope_step.step", "step_type", "condition"),
+					resource.TestCheckResourceAttr("runscope_step.step", "condition.#", "1"),
+					resource.TestCheckResourceAttr("runscope_step.step", "condition.0.left_value", "{{status}}"),
+					resource.TestCheckResourceAttr("runscope_step.step", "condition.0.comparison", "equal_number"),
+					resource.TestCheckResourceAttr("runscope_step.step", "condition.0.right_value", "200"),
+					resource.TestCheckResourceAttr("runscope_step.step", "condition.0.step.#", "1"),
+					resource.TestCheckResourceAttr("runscope_step.step", "condition.0.step.0.method", "GET"),
+					resource.TestCheckResourceAttr("runscope_step.step", "condition.0.step.0.url", "https://example.org/status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStep_subtest(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStepSubtestConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepExists("runscope_step.step"),
+					resource.TestCheckResourceAttr("runscope_step.step", "step_type", "subtest"),
+					resource.TestCheckResourceAttr("runscope_step.step", "subtest.#", "1"),
+					resource.TestCheckResourceAttrPair("runscope_step.step", "subtest.0.test_uuid", "runscope_test.subtest", "id"),
+					resource.TestCheckResourceAttrPair("runscope_step.step", "subtest.0.environment_uuid", "runscope_test.subtest", "default_environment_id"),
+					resource.TestCheckResourceAttr("runscope_step.step", "subtest.0.param.#", "1"),
+					resource.TestCheckResourceAttr("runscope_step.step", "subtest.0.param.0.name", "token"),
+					resource.TestCheckResourceAttr("runscope_step.step", "subtest.0.param.0.value", "{{token}}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStep_invalid_step_type_attributes(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccStepTypeAttributesConfig, bucketName, teamId, "pause", `url = "https://example.org"`),
+				ExpectError: regexp.MustCompile("pause step requires pause block"),
+			},
+			{
+				Config:      fmt.Sprintf(testAccStepTypeAttributesConfig, bucketName, teamId, "request", `pause { duration = 1 }`),
+				ExpectError: regexp.MustCompile("pause block is not allowed in request step"),
+			},
+			{
+				Config:      fmt.Sprintf(testAccStepTypeAttributesConfig, bucketName, teamId, "request", `method = "GET"`),
+				ExpectError: regexp.MustCompile("request step requires url"),
+			},
+		},
+	})
+}
+
 func TestAccStep_valid_variable_source(t *testing.T) {
 	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
 	bucketName := testAccRandomBucketName()
@@ -241,7 +445,7 @@ func TestAccStep_valid_variable_source(t *testing.T) {
 		Steps: func() []resource.TestStep {
 			steps := make([]resource.TestStep, len(stepSources))
 			for i, source := range stepSources {
-				steps[i].Config = fmt.Sprintf(testAccStepVariableSourcesConfig, bucketName, teamId, source)
+				steps[i].Config = fmt.Sprintf(testAccStepVariableSourcesConfig, bucketName, teamId, source, testAccStepSourceProperty(source))
 				steps[i].Check = resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("runscope_step.step", "variable.0.source", source),
 				)
@@ -260,7 +464,7 @@ func TestAccStep_invalid_variable_source(t *testing.T) {
 		CheckDestroy:      testAccCheckStepDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      fmt.Sprintf(testAccStepVariableSourcesConfig, bucketName, teamId, "invalid_source"),
+				Config:      fmt.Sprintf(testAccStepVariableSourcesConfig, bucketName, teamId, "invalid_source", ""),
 				ExpectError: regexp.MustCompile("expected variable.0.source to be one of"),
 			},
 		},
@@ -277,7 +481,7 @@ func TestAccStep_valid_assertion_source(t *testing.T) {
 		Steps: func() []resource.TestStep {
 			steps := make([]resource.TestStep, len(stepSources))
 			for i, source := range stepSources {
-				steps[i].Config = fmt.Sprintf(testAccStepAssertionSourcesConfig, bucketName, teamId, source)
+				steps[i].Config = fmt.Sprintf(testAccStepAssertionSourcesConfig, bucketName, teamId, source, testAccStepSourceProperty(source))
 				steps[i].Check = resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("runscope_step.step", "assertion.0.source", source),
 				)
@@ -296,7 +500,7 @@ func TestAccStep_invalid_assertion_source(t *testing.T) {
 		CheckDestroy:      testAccCheckStepDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      fmt.Sprintf(testAccStepAssertionSourcesConfig, bucketName, teamId, "invalid_source"),
+				Config:      fmt.Sprintf(testAccStepAssertionSourcesConfig, bucketName, teamId, "invalid_source", ""),
 				ExpectError: regexp.MustCompile("expected assertion.0.source to be one of"),
 			},
 		},
@@ -312,10 +516,14 @@ func TestAccStep_valid_assertion_comparison(t *testing.T) {
 		CheckDestroy:      testAccCheckStepDestroy,
 		Steps: func() []resource.TestStep {
 			steps := make([]resource.TestStep, len(stepComparisons))
-			for i, source := range stepComparisons {
-				steps[i].Config = fmt.Sprintf(testAccStepAssertionComparisonsConfig, bucketName, teamId, source)
+			for i, comparison := range stepComparisons {
+				value := "200"
+				if stringInSlice(stepUnaryComparisons, comparison) {
+					value = ""
+				}
+				steps[i].Config = fmt.Sprintf(testAccStepAssertionComparisonsConfig, bucketName, teamId, comparison, value)
 				steps[i].Check = resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("runscope_step.step", "assertion.0.comparison", source),
+					resource.TestCheckResourceAttr("runscope_step.step", "assertion.0.comparison", comparison),
 				)
 			}
 			return steps
@@ -332,13 +540,54 @@ func TestAccStep_invalid_assertion_comparison(t *testing.T) {
 		CheckDestroy:      testAccCheckStepDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      fmt.Sprintf(testAccStepAssertionComparisonsConfig, bucketName, teamId, "invalid_compatison"),
+				Config:      fmt.Sprintf(testAccStepAssertionComparisonsConfig, bucketName, teamId, "invalid_compatison", ""),
 				ExpectError: regexp.MustCompile("expected assertion.0.comparison to be one of"),
 			},
 		},
 	})
 }
 
+func TestAccStep_invalid_assertion(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	steps := []resource.TestStep{}
+	for _, tc := range []struct {
+		source, comparison, property, value string
+		expectedError                       string
+	}{
+		{"response_status", "equal_number", "", "ok", `assertion.1.value: equal_number comparison requires numeric value, got "ok"`},
+		{"response_time", "is_less_than", "", "", `assertion.1.value: is_less_than comparison requires numeric value, got ""`},
+		{"response_json", "empty", "data.id", "1", "assertion.1.value: value is not allowed with empty comparison"},
+		{"response_json", "is_null", "data.id", "-", "assertion.1.value: value is not allowed with is_null comparison"},
+		{"response_status", "equal", "data.id", "200", "assertion.1.property: property is not allowed with response_status source"},
+		{"response_headers", "not_empty", "", "", "assertion.1.property: response_headers source requires property"},
+	} {
+		steps = append(steps, resource.TestStep{
+			Config:      fmt.Sprintf(testAccStepInvalidAssertionConfig, bucketName, teamId, tc.source, tc.comparison, tc.property, tc.value),
+			ExpectError: regexp.MustCompile(regexp.QuoteMeta(tc.expectedError)),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config:      fmt.Sprintf(testAccStepInvalidVariableConfig, bucketName, teamId),
+		ExpectError: regexp.MustCompile(`variable "httpStatus": property is not allowed with response_status source`),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepDestroy,
+		Steps:             steps,
+	})
+}
+
+func testAccStepSourceProperty(source string) string {
+	if stringInSlice(stepPropertySources, source) {
+		return "data.id"
+	}
+	return ""
+}
+
 func testAccCheckStepDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*providerConfig).client
 	ctx := context.Background()
@@ -395,7 +644,7 @@ func testAccCheckStepExists(n string) resource.TestCheckFunc {
 	}
 }
 
-func testAccCheckStepOrder(n, s1, s2 string) resource.TestCheckFunc {
+func testAccCheckStepOrder(n string, steps ...string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		ctx := context.Background()
 
@@ -425,22 +674,19 @@ func testAccCheckStepOrder(n, s1, s2 string) resource.TestCheckFunc {
 			return fmt.Errorf("Record not found")
 		}
 
-		step1, ok := s.RootModule().Resources[s1]
-		if !ok {
-			return fmt.Errorf("Step not found: %s", s1)
+		if len(test.Steps) != len(steps) {
+			return fmt.Errorf("Expected %d steps, got %d", len(steps), len(test.Steps))
 		}
 
-		step2, ok := s.RootModule().Resources[s2]
-		if !ok {
-			return fmt.Errorf("Step not found: %s", s2)
-		}
-
-		if step1.Primary.ID != test.Steps[0].Id {
-			return fmt.Errorf("Steps not in correct order, want %s got %s", step1.Primary.ID, test.Steps[0].Id)
-		}
+		for i, name := range steps {
+			step, ok := s.RootModule().Resources[name]
+			if !ok {
+				return fmt.Errorf("Step not found: %s", name)
+			}
 
-		if step2.Primary.ID != test.Steps[1].Id {
-			return fmt.Errorf("Steps not in correct order, want %s got %s", step2.Primary.ID, test.Steps[1].Id)
+			if step.Primary.ID != test.Steps[i].Id {
+				return fmt.Errorf("Steps not in correct order, want %s got %s", step.Primary.ID, test.Steps[i].Id)
+			}
 		}
 
 		return nil
@@ -469,6 +715,248 @@ resource "runscope_step" "step" {
 }
 `
 
+const testAccStepParallelConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test"
+}
+
+resource "runscope_step" "step" {
+  count = 5
+
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "request"
+  method    = "GET"
+  url       = "https://example.org/${count.index}"
+}
+`
+
+const testAccStepAfterStepIdConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "runscope test"
+  description = "This is a test test..."
+}
+
+resource "runscope_step" "step_a" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "request"
+  method    = "GET"
+  url       = "https://example.org/a"
+}
+
+resource "runscope_step" "step_b" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "request"
+  method    = "GET"
+  url       = "https://example.org/b"
+
+  depends_on = [runscope_step.step_a]
+}
+
+resource "runscope_step" "step_c" {
+  bucket_id     = runscope_bucket.bucket.id
+  test_id       = runscope_test.test.id
+  after_step_id = %s
+
+  step_type = "request"
+  method    = "GET"
+  url       = "https://example.org/c"
+
+  depends_on = [runscope_step.step_b]
+}
+`
+
+const testAccStepJsonBodyConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test"
+}
+
+resource "runscope_step" "step" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "request"
+  method    = "POST"
+  url       = "https://example.org/items"
+
+  header {
+    header = "Content-Type"
+    value  = "application/json"
+  }
+
+  body = %s
+}
+`
+
+const testAccStepMultipartFormConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "runscope test"
+  description = "This is a test test..."
+}
+
+resource "runscope_step" "step" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "request"
+  method    = "POST"
+  url       = "https://example.org/upload"
+
+  multipart_form_parameter {
+    name  = "title"
+    value = "report"
+  }
+
+  multipart_form_parameter {
+    name         = "file"
+    value        = "a,b"
+    type         = "file"
+    filename     = "report.csv"
+    content_type = "text/csv"
+  }
+}
+`
+
+const testAccStepPauseConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "runscope test"
+  description = "This is a test test..."
+}
+
+resource "runscope_step" "step" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "pause"
+
+  pause {
+    duration = 5
+  }
+}
+`
+
+const testAccStepConditionConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "runscope test"
+  description = "This is a test test..."
+}
+
+resource "runscope_step" "step" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "condition"
+
+  condition {
+    left_value  = "{{status}}"
+    comparison  = "equal_number"
+    right_value = "200"
+
+    step {
+      method = "GET"
+      url    = "https://example.org/status"
+    }
+  }
+}
+`
+
+const testAccStepSubtestConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "runscope test"
+  description = "This is a test test..."
+}
+
+resource "runscope_test" "subtest" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope subtest"
+}
+
+resource "runscope_step" "step" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "subtest"
+
+  subtest {
+    test_uuid        = runscope_test.subtest.id
+    environment_uuid = runscope_test.subtest.default_environment_id
+
+    param {
+      name  = "token"
+      value = "{{token}}"
+    }
+  }
+}
+`
+
+const testAccStepTypeAttributesConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "runscope test"
+  description = "This is a test test..."
+}
+
+resource "runscope_step" "step" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "%s"
+  %s
+}
+`
+
 const testAccStepVariableSourcesConfig = `
 resource "runscope_bucket" "bucket" {
   name      = "%s"
@@ -492,6 +980,7 @@ resource "runscope_step" "step" {
   variable {
     name     = "httpStatus"
     source   = "%s"
+    property = "%s"
   }
 }
 `
@@ -520,7 +1009,7 @@ resource "runscope_step" "step" {
     source     = "%s"
     comparison = "equal"
     value      = "c5baeb4a-2379-478a-9cda-1b671de77cf9"
-    property   = "data.id"
+    property   = "%s"
   }
 }
 `
@@ -546,9 +1035,71 @@ resource "runscope_step" "step" {
   url       = "https://example.org"
 
   assertion {
-    source     = "response_status"
+    source     = "response_json"
     comparison = "%s"
     property   = "data.id"
+    value      = "%s"
+  }
+}
+`
+
+const testAccStepInvalidAssertionConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test"
+}
+
+resource "runscope_step" "step" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "request"
+  method    = "GET"
+  url       = "https://example.org"
+
+  assertion {
+    source     = "response_status"
+    comparison = "equal_number"
+    value      = "200"
+  }
+
+  assertion {
+    source     = "%s"
+    comparison = "%s"
+    property   = "%s"
+    value      = "%s"
+  }
+}
+`
+
+const testAccStepInvalidVariableConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test"
+}
+
+resource "runscope_step" "step" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "request"
+  method    = "GET"
+  url       = "https://example.org"
+
+  variable {
+    name     = "httpStatus"
+    source   = "response_status"
+    property = "status"
   }
 }
 `
@@ -681,3 +1232,27 @@ resource "runscope_step" "step_a" {
   method         = "GET"
 }
 `
+
+func TestStepBlock_GetOk(t *testing.T) {
+	block := stepBlock{
+		"url":      "",
+		"skipped":  false,
+		"duration": 0,
+		"header":   []interface{}{},
+		"note":     "note",
+		"retries":  1,
+	}
+	for key, expected := range map[string]bool{
+		"url":      false,
+		"skipped":  false,
+		"duration": false,
+		"header":   false,
+		"method":   false,
+		"note":     true,
+		"retries":  true,
+	} {
+		if _, ok := block.GetOk(key); ok != expected {
+			t.Errorf("%s: expected %t, got %t", key, expected, ok)
+		}
+	}
+}
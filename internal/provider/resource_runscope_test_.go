@@ -4,10 +4,12 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,8 +21,20 @@ func resourceRunscopeTest() *schema.Resource {
 		ReadContext:   resourceTestRead,
 		UpdateContext: resourceTestUpdate,
 		DeleteContext: resourceTestDelete,
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			steps := d.Get("step").([]interface{})
+			if len(steps) > 0 && !d.Get("manage_steps").(bool) {
+				return fmt.Errorf("step blocks require manage_steps to be true")
+			}
+			for i := range steps {
+				if err := validateStep(d, fmt.Sprintf("step.%d.", i)); err != nil {
+					return fmt.Errorf("step.%d: %s", i, err)
+				}
+			}
+			return nil
+		},
 		Importer: &schema.ResourceImporter{
-			StateContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 				parts := strings.SplitN(d.Id(), "/", 2)
 				if len(parts) < 2 {
 					return nil, fmt.Errorf("test ID for import should be in format bucket_id/test_id")
@@ -28,6 +42,9 @@ func resourceRunscopeTest() *schema.Resource {
 
 				d.Set("bucket_id", parts[0])
 				d.SetId(parts[1])
+				// Steps are taken over only when manage_steps is set
+				// in the configuration.
+				d.Set("manage_steps", false)
 
 				return []*schema.ResourceData{d}, nil
 			},
@@ -79,10 +96,31 @@ func resourceRunscopeTest() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"manage_steps": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"step": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: testStepSchema(),
+				},
+			},
 		},
 	}
 }
 
+func testStepSchema() map[string]*schema.Schema {
+	s := stepBaseSchema()
+	s["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	return s
+}
+
 func resourceTestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*providerConfig).client
 
@@ -98,6 +136,12 @@ func resourceTestCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.SetId(test.Id)
 
+	if v, ok := d.GetOk("step"); ok && d.Get("manage_steps").(bool) {
+		if err := updateTestSteps(ctx, client, opts.BucketId, test.Id, v.([]interface{})); err != nil {
+			return diag.Errorf("Couldn't create test steps: %s", err)
+		}
+	}
+
 	return resourceTestRead(ctx, d, meta)
 }
 
@@ -125,6 +169,11 @@ func resourceTestRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("created_at", flattenTime(test.CreatedAt))
 	d.Set("created_by", flattenCreatedBy(&test.CreatedBy))
 	d.Set("trigger_url", test.TriggerURL)
+	if d.Get("manage_steps").(bool) {
+		d.Set("step", flattenSteps(test.Steps))
+	} else {
+		d.Set("step", nil)
+	}
 	return nil
 }
 
@@ -143,6 +192,12 @@ func resourceTestUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("Error updating test: %s", err)
 	}
 
+	if d.Get("manage_steps").(bool) && d.HasChanges("manage_steps", "step") {
+		if err := updateTestSteps(ctx, client, opts.BucketId, opts.Id, d.Get("step").([]interface{})); err != nil {
+			return diag.Errorf("Error updating test steps: %s", err)
+		}
+	}
+
 	return resourceTestRead(ctx, d, meta)
 }
 
@@ -154,9 +209,110 @@ func resourceTestDelete(ctx context.Context, d *schema.ResourceData, meta interf
 		BucketId: d.Get("bucket_id").(string),
 	}
 
-	if err := client.Test.Delete(ctx, opts); err != nil {
+	if err := client.Test.Delete(ctx, opts); err != nil && !isNotFound(err) {
 		return diag.Errorf("Error deleting test: %s", err)
 	}
 
 	return nil
 }
+
+// updateTestSteps makes steps of the test match step blocks. Unchanged
+// steps are kept wherever they have moved, changed steps are updated by
+// their IDs, the rest of blocks are created as new steps and steps without
+// blocks are deleted. Finally steps are reordered to the order of blocks.
+func updateTestSteps(ctx context.Context, client *runscope.Client, bucketId, testId string, blocks []interface{}) error {
+	defer lockTestSteps(bucketId, testId)()
+
+	test, err := client.Test.Get(ctx, runscope.TestGetOpts{BucketId: bucketId, Id: testId})
+	if err != nil {
+		return err
+	}
+
+	// Read existing steps the same way as step blocks, to compare them.
+	data := resourceRunscopeTest().Data(nil)
+	if err := data.Set("step", flattenSteps(test.Steps)); err != nil {
+		return err
+	}
+	existing := map[string]map[string]interface{}{}
+	for _, step := range data.Get("step").([]interface{}) {
+		step := step.(map[string]interface{})
+		existing[step["id"].(string)] = step
+	}
+
+	ids := make([]string, len(blocks))
+	for i, block := range blocks {
+		for _, step := range test.Steps {
+			if _, ok := existing[step.Id]; ok && stepBlockKey(existing[step.Id]) == stepBlockKey(block) {
+				ids[i] = step.Id
+				delete(existing, step.Id)
+				break
+			}
+		}
+	}
+
+	uriOpts := runscope.StepUriOpts{BucketId: bucketId, TestId: testId}
+	for i, block := range blocks {
+		if ids[i] != "" {
+			continue
+		}
+		block := block.(map[string]interface{})
+		id, _ := block["id"].(string)
+		step, ok := existing[id]
+		if !ok || step["step_type"] != block["step_type"] {
+			continue
+		}
+
+		opts := &runscope.StepUpdateOpts{}
+		opts.StepUriOpts = uriOpts
+		opts.Id = id
+		expandStepBaseOpts(stepBlock(block), &opts.StepBaseOpts)
+		if _, err := client.Step.Update(ctx, opts); err != nil {
+			return err
+		}
+		ids[i] = id
+		delete(existing, id)
+	}
+
+	var order []string
+	for _, step := range test.Steps {
+		if _, ok := existing[step.Id]; ok {
+			opts := &runscope.StepDeleteOpts{}
+			opts.StepUriOpts = uriOpts
+			opts.Id = step.Id
+			if err := client.Step.Delete(ctx, opts); err != nil && !isNotFound(err) {
+				return err
+			}
+			continue
+		}
+		order = append(order, step.Id)
+	}
+
+	for i, block := range blocks {
+		if ids[i] != "" {
+			continue
+		}
+
+		opts := &runscope.StepCreateOpts{StepUriOpts: uriOpts}
+		expandStepBaseOpts(stepBlock(block.(map[string]interface{})), &opts.StepBaseOpts)
+		step, err := client.Step.Create(ctx, opts)
+		if err != nil {
+			return err
+		}
+		ids[i] = step.Id
+		order = append(order, step.Id)
+	}
+
+	if len(ids) == 0 || reflect.DeepEqual(order, ids) {
+		return nil
+	}
+	_, err = client.Step.Reorder(ctx, &runscope.StepReorderOpts{StepUriOpts: uriOpts, Ids: ids})
+	return err
+}
+
+// stepBlockKey serializes arguments of the step block, so that equal
+// blocks have equal keys.
+func stepBlockKey(block interface{}) string {
+	var buf bytes.Buffer
+	schema.SerializeResourceForHash(&buf, block, &schema.Resource{Schema: testStepSchema()})
+	return buf.String()
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRunscopeTestImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTestImportCreate,
+		ReadContext:   resourceTestImportRead,
+		DeleteContext: resourceTestImportDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trigger_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTestImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := runscope.TestImportOpts{
+		BucketId: d.Get("bucket_id").(string),
+		Document: []byte(d.Get("document").(string)),
+	}
+
+	test, err := client.Test.Import(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Failed to import test: %s", err)
+	}
+
+	d.SetId(test.Id)
+
+	return resourceTestImportRead(ctx, d, meta)
+}
+
+func resourceTestImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := runscope.TestGetOpts{
+		BucketId: d.Get("bucket_id").(string),
+		Id:       d.Id(),
+	}
+
+	test, err := client.Test.Get(ctx, opts)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Couldn't read test: %s", err)
+	}
+
+	d.Set("name", test.Name)
+	d.Set("description", test.Description)
+	d.Set("default_environment_id", test.DefaultEnvironmentId)
+	d.Set("trigger_url", test.TriggerURL)
+	return nil
+}
+
+func resourceTestImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := runscope.TestDeleteOpts{
+		Id:       d.Id(),
+		BucketId: d.Get("bucket_id").(string),
+	}
+
+	if err := client.Test.Delete(ctx, opts); err != nil && !isNotFound(err) {
+		return diag.Errorf("Error deleting test: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTestImport_copy_test(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	test := &runscope.Test{}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTestImportConfig, bucketName, teamId, bucketName+"-copy", teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestExists("runscope_test_import.copy", test),
+					testAccCheckTestStepURLs(test, "https://example.com/status"),
+					resource.TestCheckResourceAttr("runscope_test_import.copy", "name", "runscope original test"),
+					resource.TestCheckResourceAttr("runscope_test_import.copy", "description", "copied with export"),
+					resource.TestCheckResourceAttrSet("runscope_test_import.copy", "default_environment_id"),
+					resource.TestCheckResourceAttrSet("runscope_test_import.copy", "trigger_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTestImportDestroy(s *terraform.State) error {
+	ctx := context.Background()
+	client := testAccProvider.Meta().(*providerConfig).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "runscope_test_import" {
+			continue
+		}
+
+		opts := runscope.TestGetOpts{}
+		opts.Id = rs.Primary.ID
+		opts.BucketId = rs.Primary.Attributes["bucket_id"]
+
+		if _, err := client.Test.Get(ctx, opts); err == nil {
+			return fmt.Errorf("record %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccTestImportConfig = `
+resource "runscope_bucket" "original" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "original" {
+  bucket_id   = runscope_bucket.original.id
+  name        = "runscope original test"
+  description = "copied with export"
+
+  manage_steps = true
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com/status"
+  }
+}
+
+data "runscope_test_export" "original" {
+  bucket_id = runscope_bucket.original.id
+  test_id   = runscope_test.original.id
+}
+
+resource "runscope_bucket" "copy" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test_import" "copy" {
+  bucket_id = runscope_bucket.copy.id
+  document  = data.runscope_test_export.original.json
+}
+`
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRunscopeTestRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTestRunCreate,
+		ReadContext:   resourceTestRunRead,
+		DeleteContext: resourceTestRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"trigger_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assertions_passed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"assertions_failed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"test_run_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"run": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     testRunResource(),
+			},
+		},
+	}
+}
+
+func resourceTestRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := &runscope.TestRunTriggerOpts{
+		TriggerURL:    d.Get("trigger_url").(string),
+		EnvironmentId: d.Get("environment_id").(string),
+		Variables:     map[string]string{},
+	}
+	for name, value := range d.Get("variables").(map[string]interface{}) {
+		opts.Variables[name] = value.(string)
+	}
+
+	runs, err := client.TestRun.Trigger(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Couldn't trigger test run: %s", err)
+	}
+	if len(runs) == 0 {
+		return diag.Errorf("Couldn't trigger test run: no tests to run")
+	}
+
+	results, err := waitForTestRuns(ctx, client, runs, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("Couldn't wait for test run: %s", err)
+	}
+
+	d.SetId(runs[0].TestRunId)
+	if len(runs) == 1 {
+		d.Set("test_run_url", runs[0].TestRunURL)
+	}
+
+	if failed := flattenTestRunResults(d, runs, results); len(failed) > 0 {
+		return diag.Errorf("%d of %d test runs failed: %s", len(failed), len(runs), strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+func resourceTestRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceTestRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// waitForTestRuns polls results of the runs until all of them are finished.
+// Results which aren't available yet are treated as pending.
+func waitForTestRuns(ctx context.Context, client *runscope.Client, runs []*runscope.TestRun, timeout time.Duration) ([]*runscope.Result, error) {
+	results := make([]*runscope.Result, len(runs))
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		for i, run := range runs {
+			if results[i] != nil && results[i].Finished() {
+				continue
+			}
+
+			opts := &runscope.ResultGetOpts{BucketId: run.BucketKey, TestId: run.TestId, Id: run.TestRunId}
+			result, err := client.Result.Get(ctx, opts)
+			if err != nil {
+				if isNotFound(err) {
+					return resource.RetryableError(fmt.Errorf("result of test %s run %s isn't available yet", run.TestId, run.TestRunId))
+				}
+				return resource.NonRetryableError(err)
+			}
+
+			results[i] = result
+			if !result.Finished() {
+				return resource.RetryableError(fmt.Errorf("test %s run %s is %s", run.TestId, run.TestRunId, result.Result))
+			}
+		}
+		return nil
+	})
+
+	return results, err
+}
+
+// testRunResource is the schema of a test run result.
+func testRunResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"test_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"test_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"test_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assertions_passed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"assertions_failed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"test_run_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// flattenTestRunResults sets results of the runs and returns URLs
+// of the failed ones.
+func flattenTestRunResults(d *schema.ResourceData, runs []*runscope.TestRun, results []*runscope.Result) []string {
+	aggregated := "pass"
+	var failed []string
+	assertionsPassed := 0
+	assertionsFailed := 0
+
+	items := make([]map[string]interface{}, len(runs))
+	for i, run := range runs {
+		result := results[i]
+		if result.Result != "pass" {
+			aggregated = "fail"
+			failed = append(failed, run.TestRunURL)
+		}
+		assertionsPassed += result.AssertionsPassed
+		assertionsFailed += result.AssertionsFailed
+
+		items[i] = map[string]interface{}{
+			"test_id":           run.TestId,
+			"test_name":         run.TestName,
+			"test_run_id":       run.TestRunId,
+			"environment_id":    run.EnvironmentId,
+			"region":            run.Region,
+			"result":            result.Result,
+			"assertions_passed": result.AssertionsPassed,
+			"assertions_failed": result.AssertionsFailed,
+			"test_run_url":      run.TestRunURL,
+		}
+	}
+
+	d.Set("result", aggregated)
+	d.Set("assertions_passed", assertionsPassed)
+	d.Set("assertions_failed", assertionsFailed)
+	d.Set("run", items)
+
+	return failed
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTestRun_basic(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTestRunConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("runscope_test_run.test", "result", "pass"),
+					resource.TestCheckResourceAttr("runscope_test_run.test", "assertions_passed", "1"),
+					resource.TestCheckResourceAttr("runscope_test_run.test", "assertions_failed", "0"),
+					resource.TestCheckResourceAttrSet("runscope_test_run.test", "test_run_url"),
+					resource.TestCheckResourceAttr("runscope_test_run.test", "run.#", "1"),
+					resource.TestCheckResourceAttrPair("runscope_test_run.test", "run.0.test_id", "runscope_test.test", "id"),
+					resource.TestCheckResourceAttrPair("runscope_test_run.test", "run.0.environment_id", "runscope_test.test", "default_environment_id"),
+					resource.TestCheckResourceAttr("runscope_test_run.bucket", "result", "pass"),
+					resource.TestCheckResourceAttr("runscope_test_run.bucket", "run.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTestRunConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test run"
+
+  manage_steps = true
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com"
+
+    assertion {
+      source     = "response_status"
+      comparison = "equal_number"
+      value      = "200"
+    }
+  }
+}
+
+resource "runscope_test_run" "test" {
+  trigger_url = runscope_test.test.trigger_url
+
+  variables = {
+    base_url = "https://example.com"
+  }
+}
+
+resource "runscope_test_run" "bucket" {
+  trigger_url = runscope_bucket.bucket.trigger_url
+  depends_on  = [runscope_test.test]
+}
+`
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscopetest"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -122,6 +124,217 @@ func TestAccTest_update_test(t *testing.T) {
 	})
 }
 
+func TestAccTest_steps(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	created := &runscope.Test{}
+	test := &runscope.Test{}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTestStepsConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestExists("runscope_test.test", created),
+					testAccCheckTestStepURLs(created, "https://example.com/a", "https://example.com/b"),
+					resource.TestCheckResourceAttr("runscope_test.test", "manage_steps", "true"),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.#", "2"),
+					resource.TestCheckResourceAttrSet("runscope_test.test", "step.0.id"),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.0.url", "https://example.com/a"),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.0.assertion.#", "1"),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.0.assertion.0.value", "200"),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.1.method", "POST"),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.1.body", "{}"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccTestStepsUpdatedConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestExists("runscope_test.test", test),
+					testAccCheckTestStepURLs(test, "https://example.com/a", "https://example.com/c", "https://example.com/b"),
+					testAccCheckTestStepKept(created, test, 0, 0),
+					testAccCheckTestStepKept(created, test, 1, 2),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.#", "3"),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.1.url", "https://example.com/c"),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.2.url", "https://example.com/b"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccTestStepsConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestExists("runscope_test.test", test),
+					testAccCheckTestStepURLs(test, "https://example.com/a", "https://example.com/b"),
+					testAccCheckTestStepKept(created, test, 0, 0),
+					testAccCheckTestStepKept(created, test, 1, 1),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "runscope_test.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manage_steps", "step"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["runscope_test.test"]
+					if !ok {
+						return "", fmt.Errorf("not found runscope_test.test")
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["bucket_id"], rs.Primary.ID), nil
+				},
+			},
+			{
+				Config: fmt.Sprintf(testAccTestDefaultConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestExists("runscope_test.test", test),
+					testAccCheckTestStepURLs(test, "https://example.com/a", "https://example.com/b"),
+					resource.TestCheckResourceAttr("runscope_test.test", "manage_steps", "false"),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccTestNoStepsConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestExists("runscope_test.test", test),
+					testAccCheckTestStepURLs(test),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTest_import_with_step_resources(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	test := &runscope.Test{}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTestStepResourcesConfig, bucketName, teamId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestExists("runscope_test.test", test),
+					testAccCheckTestStepURLs(test, "https://example.com/a", "https://example.com/b"),
+					resource.TestCheckResourceAttr("runscope_test.test", "step.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "runscope_test.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["runscope_test.test"]
+					if !ok {
+						return "", fmt.Errorf("not found runscope_test.test")
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["bucket_id"], rs.Primary.ID), nil
+				},
+			},
+			{
+				Config:   fmt.Sprintf(testAccTestStepResourcesConfig, bucketName, teamId),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccTest_invalid_step_assertion(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccTestInvalidStepAssertionConfig, bucketName, teamId),
+				ExpectError: regexp.MustCompile(`step.1: assertion.0.value: equal_number comparison requires numeric value, got "OK"`),
+			},
+		},
+	})
+}
+
+func TestUpdateTestSteps(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	client := runscope.NewClient(runscope.WithEndpoint(server.URL), runscope.WithRetryMax(0))
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: "c8ffd67b-c281-45d3-9735-3f40ee567a02"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := runscope.TestCreateOpts{}
+	opts.BucketId = bucket.Key
+	opts.Name = "test"
+	test, err := client.Test.Create(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// updateSteps updates steps to blocks with the given IDs and URLs,
+	// IDs are those the plan takes from the state by position.
+	updateSteps := func(idsAndURLs ...string) []runscope.Step {
+		var steps []interface{}
+		for i := 0; i < len(idsAndURLs); i += 2 {
+			steps = append(steps, map[string]interface{}{
+				"id":        idsAndURLs[i],
+				"step_type": "request",
+				"method":    "GET",
+				"url":       idsAndURLs[i+1],
+			})
+		}
+		d := resourceRunscopeTest().Data(nil)
+		if err := d.Set("step", steps); err != nil {
+			t.Fatal(err)
+		}
+		if err := updateTestSteps(ctx, client, bucket.Key, test.Id, d.Get("step").([]interface{})); err != nil {
+			t.Fatal(err)
+		}
+		test, err := client.Test.Get(ctx, runscope.TestGetOpts{BucketId: bucket.Key, Id: test.Id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(test.Steps) != len(steps) {
+			t.Fatalf("expected %d steps, got %d", len(steps), len(test.Steps))
+		}
+		for i, step := range test.Steps {
+			if url := idsAndURLs[2*i+1]; step.StepURL != url {
+				t.Errorf("expected step %d url %s, got %s", i, url, step.StepURL)
+			}
+		}
+		return test.Steps
+	}
+
+	steps := updateSteps("", "https://example.com/a", "", "https://example.com/b")
+	a, b := steps[0].Id, steps[1].Id
+
+	// Inserted block is created, steps after it keep their IDs.
+	steps = updateSteps(a, "https://example.com/a", b, "https://example.com/c", "", "https://example.com/b")
+	if steps[0].Id != a || steps[1].Id == a || steps[1].Id == b || steps[2].Id != b {
+		t.Errorf("unexpected step IDs %s, %s, %s, expected %s, <new>, %s", steps[0].Id, steps[1].Id, steps[2].Id, a, b)
+	}
+	c := steps[1].Id
+
+	// Changed block updates the step in place.
+	steps = updateSteps(a, "https://example.com/a", c, "https://example.com/d", b, "https://example.com/b")
+	if steps[0].Id != a || steps[1].Id != c || steps[2].Id != b {
+		t.Errorf("unexpected step IDs %s, %s, %s, expected %s, %s, %s", steps[0].Id, steps[1].Id, steps[2].Id, a, c, b)
+	}
+
+	// Removed block deletes the step, moved blocks keep their steps.
+	steps = updateSteps(a, "https://example.com/b", c, "https://example.com/a")
+	if steps[0].Id != b || steps[1].Id != a {
+		t.Errorf("unexpected step IDs %s, %s, expected %s, %s", steps[0].Id, steps[1].Id, b, a)
+	}
+
+	updateSteps()
+}
+
 func testAccCheckTestDestroy(s *terraform.State) error {
 	ctx := context.Background()
 	client := testAccProvider.Meta().(*providerConfig).client
@@ -176,6 +389,29 @@ func testAccCheckTestExists(n string, t *runscope.Test) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckTestStepURLs(t *runscope.Test, urls ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(t.Steps) != len(urls) {
+			return fmt.Errorf("expected %d steps, got %d", len(urls), len(t.Steps))
+		}
+		for i, url := range urls {
+			if t.Steps[i].StepURL != url {
+				return fmt.Errorf("expected step %d url \"%s\", got \"%s\"", i, url, t.Steps[i].StepURL)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckTestStepKept(before, after *runscope.Test, from, to int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.Steps[from].Id != after.Steps[to].Id {
+			return fmt.Errorf("expected step %d to be step %d \"%s\", got \"%s\"", to, from, before.Steps[from].Id, after.Steps[to].Id)
+		}
+		return nil
+	}
+}
+
 func testAccCheckTestIdEqual(t1, t2 *runscope.Test) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if t1.Id != t2.Id {
@@ -211,3 +447,152 @@ resource "runscope_test" "test" {
   description = "runscope custom test description"
 }
 `
+
+const testAccTestStepsConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test with steps"
+
+  manage_steps = true
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com/a"
+
+    assertion {
+      source     = "response_status"
+      comparison = "equal_number"
+      value      = "200"
+    }
+  }
+
+  step {
+    step_type = "request"
+    method    = "POST"
+    url       = "https://example.com/b"
+    body      = "{}"
+  }
+}
+`
+
+const testAccTestStepsUpdatedConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test with steps"
+
+  manage_steps = true
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com/a"
+
+    assertion {
+      source     = "response_status"
+      comparison = "equal_number"
+      value      = "200"
+    }
+  }
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com/c"
+  }
+
+  step {
+    step_type = "request"
+    method    = "POST"
+    url       = "https://example.com/b"
+    body      = "{}"
+  }
+}
+`
+
+const testAccTestNoStepsConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test with steps"
+
+  manage_steps = true
+}
+`
+
+const testAccTestStepResourcesConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test with step resources"
+}
+
+resource "runscope_step" "a" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  step_type = "request"
+  method    = "GET"
+  url       = "https://example.com/a"
+}
+
+resource "runscope_step" "b" {
+  bucket_id     = runscope_bucket.bucket.id
+  test_id       = runscope_test.test.id
+  after_step_id = runscope_step.a.id
+
+  step_type = "request"
+  method    = "GET"
+  url       = "https://example.com/b"
+}
+`
+
+const testAccTestInvalidStepAssertionConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test with steps"
+
+  manage_steps = true
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com/a"
+  }
+
+  step {
+    step_type = "request"
+    method    = "GET"
+    url       = "https://example.com/b"
+
+    assertion {
+      source     = "response_status"
+      comparison = "equal_number"
+      value      = "OK"
+    }
+  }
+}
+`
@@ -64,6 +64,24 @@ func flattenFormParameters(form map[string][]string) []interface{} {
 	return result
 }
 
+func flattenStepMultipartForm(parts []runscope.StepMultipartPart) []interface{} {
+	result := make([]interface{}, len(parts))
+	for i, p := range parts {
+		partType := p.Type
+		if partType == "" {
+			partType = "text"
+		}
+		result[i] = map[string]interface{}{
+			"name":         p.Name,
+			"value":        p.Value,
+			"type":         partType,
+			"filename":     p.Filename,
+			"content_type": p.ContentType,
+		}
+	}
+	return result
+}
+
 func flattenStepAuth(auth runscope.StepAuth) []map[string]interface{} {
 	return []map[string]interface{}{{
 		"username":  auth.Username,
@@ -127,6 +145,21 @@ func expandStepForm(formParameters []interface{}) map[string][]string {
 	return result
 }
 
+func expandStepMultipartForm(parts []interface{}) []runscope.StepMultipartPart {
+	result := make([]runscope.StepMultipartPart, len(parts))
+	for i, part := range parts {
+		p := part.(map[string]interface{})
+		result[i] = runscope.StepMultipartPart{
+			Name:        p["name"].(string),
+			Value:       p["value"].(string),
+			Type:        p["type"].(string),
+			Filename:    p["filename"].(string),
+			ContentType: p["content_type"].(string),
+		}
+	}
+	return result
+}
+
 func expandStepAuth(auth []interface{}) runscope.StepAuth {
 	result := runscope.StepAuth{}
 	if len(auth) > 0 {
@@ -138,6 +171,118 @@ func expandStepAuth(auth []interface{}) runscope.StepAuth {
 	return result
 }
 
+func flattenSteps(steps []runscope.Step) []interface{} {
+	result := make([]interface{}, len(steps))
+	for i, step := range steps {
+		s := flattenStepRequest(&step.StepBase)
+		s["id"] = step.Id
+		s["step_type"] = step.StepType
+		s["pause"] = flattenStepPause(&step.StepBase)
+		s["condition"] = flattenStepCondition(&step.StepBase)
+		s["subtest"] = flattenStepSubtest(&step.StepBase)
+		s["ghost_inspector"] = flattenStepGhostInspector(&step.StepBase)
+		result[i] = s
+	}
+	return result
+}
+
+func flattenStepRequest(step *runscope.StepBase) map[string]interface{} {
+	s := map[string]interface{}{
+		"method":                   step.Method,
+		"url":                      step.StepURL,
+		"variable":                 flattenStepVariables(step.Variables),
+		"assertion":                flattenStepAssertions(step.Assertions),
+		"header":                   flattenStepHeaders(step.Headers),
+		"body":                     step.Body,
+		"form_parameter":           flattenFormParameters(step.Form),
+		"multipart_form_parameter": flattenStepMultipartForm(step.MultipartForm),
+		"scripts":                  step.Scripts,
+		"before_scripts":           step.BeforeScripts,
+		"note":                     step.Note,
+		"skipped":                  step.Skipped,
+	}
+	if !step.Auth.Empty() {
+		s["auth"] = flattenStepAuth(step.Auth)
+	}
+	return s
+}
+
+func flattenStepPause(step *runscope.StepBase) []interface{} {
+	if step.StepType != "pause" {
+		return []interface{}{}
+	}
+	return []interface{}{map[string]interface{}{
+		"duration": step.Duration,
+	}}
+}
+
+func flattenStepCondition(step *runscope.StepBase) []interface{} {
+	if step.StepType != "condition" {
+		return []interface{}{}
+	}
+	steps := make([]interface{}, len(step.Steps))
+	for i := range step.Steps {
+		steps[i] = flattenStepRequest(&step.Steps[i].StepBase)
+	}
+	return []interface{}{map[string]interface{}{
+		"left_value":  step.LeftValue,
+		"comparison":  step.Comparison,
+		"right_value": step.RightValue,
+		"step":        steps,
+	}}
+}
+
+func flattenStepSubtest(step *runscope.StepBase) []interface{} {
+	if step.StepType != "subtest" {
+		return []interface{}{}
+	}
+	params := make([]interface{}, len(step.Params))
+	for i, p := range step.Params {
+		params[i] = map[string]interface{}{
+			"name":  p.Name,
+			"value": p.Value,
+		}
+	}
+	return []interface{}{map[string]interface{}{
+		"test_uuid":        step.TestUUID,
+		"environment_uuid": step.EnvironmentUUID,
+		"bucket_key":       step.BucketKey,
+		"param":            params,
+	}}
+}
+
+func flattenStepGhostInspector(step *runscope.StepBase) []interface{} {
+	if step.StepType != "ghost" {
+		return []interface{}{}
+	}
+	return []interface{}{map[string]interface{}{
+		"integration_id": step.IntegrationId,
+		"test_id":        step.GhostTestId,
+		"start_url":      step.StartURL,
+	}}
+}
+
+func expandStepParams(params []interface{}) []runscope.StepParam {
+	result := make([]runscope.StepParam, len(params))
+	for i, param := range params {
+		p := param.(map[string]interface{})
+		result[i] = runscope.StepParam{
+			Name:  p["name"].(string),
+			Value: p["value"].(string),
+		}
+	}
+	return result
+}
+
+func expandConditionSteps(steps []interface{}) []runscope.StepBaseOpts {
+	result := make([]runscope.StepBaseOpts, len(steps))
+	for i, step := range steps {
+		expandStepBaseOpts(stepBlock(step.(map[string]interface{})), &result[i])
+		result[i].StepType = "request"
+	}
+	return result
+}
+
 func flattenTime(t time.Time) string {
 	if t.Unix() == 0 {
 		return ""
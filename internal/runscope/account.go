@@ -0,0 +1,49 @@
+package runscope
+
+import (
+	"context"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+	"time"
+)
+
+// Account is the user the access token belongs to.
+type Account struct {
+	Id        string
+	Name      string
+	Email     string
+	CreatedAt time.Time
+	Teams     []Team
+}
+
+type AccountClient struct {
+	client *Client
+}
+
+func AccountFromSchema(s *schema.Account) *Account {
+	account := &Account{
+		Id:        s.Id,
+		Name:      s.Name,
+		Email:     s.Email,
+		CreatedAt: time.Unix(s.CreatedAt, 0),
+		Teams:     make([]Team, len(s.Teams)),
+	}
+	for i, team := range s.Teams {
+		account.Teams[i] = Team{Name: team.Name, UUID: team.Id}
+	}
+	return account
+}
+
+func (c *AccountClient) Get(ctx context.Context) (*Account, error) {
+	req, err := c.client.NewRequest(ctx, "GET", "/account", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.AccountGetResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return AccountFromSchema(&resp.Account), nil
+}
@@ -110,6 +110,35 @@ func (c *BucketClient) List(ctx context.Context) ([]*Bucket, error) {
 	return buckets, nil
 }
 
+type BucketUpdateOpts struct {
+	BucketGetOpts
+	Name      string
+	VerifySSL bool
+}
+
+func (opts *BucketUpdateOpts) setRequest(body *schema.BucketUpdateRequest) {
+	body.Name = opts.Name
+	body.VerifySSL = opts.VerifySSL
+}
+
+func (c *BucketClient) Update(ctx context.Context, opts *BucketUpdateOpts) (*Bucket, error) {
+	body := schema.BucketUpdateRequest{}
+	opts.setRequest(&body)
+
+	req, err := c.client.NewRequest(ctx, "PUT", opts.URL(), &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.BucketUpdateResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return BucketFromSchema(&resp.Bucket), nil
+}
+
 type BucketDeleteOpts struct {
 	BucketGetOpts
 }
@@ -122,3 +151,26 @@ func (c *BucketClient) Delete(ctx context.Context, opts *BucketDeleteOpts) error
 
 	return c.client.Do(req, nil)
 }
+
+type BucketTriggerOpts struct {
+	BucketGetOpts
+	// EnvironmentId overrides the default environment of the tests.
+	EnvironmentId string
+	// Variables override initial variables of the environment.
+	Variables map[string]string
+}
+
+// Trigger starts runs of all tests of the bucket using its trigger URL,
+// and returns them without waiting for results.
+func (c *BucketClient) Trigger(ctx context.Context, opts *BucketTriggerOpts) ([]*TestRun, error) {
+	bucket, err := c.Get(ctx, &opts.BucketGetOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.client.TestRun.Trigger(ctx, &TestRunTriggerOpts{
+		TriggerURL:    bucket.TriggerURL,
+		EnvironmentId: opts.EnvironmentId,
+		Variables:     opts.Variables,
+	})
+}
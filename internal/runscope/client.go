@@ -5,17 +5,38 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
 	"io/ioutil"
+	"log"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 const DefaultEndpoint = "https://api.runscope.com"
 
+const (
+	DefaultRetryMax     = 4
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
 type Client struct {
-	endpoint   string
-	token      string
-	httpClient *http.Client
+	endpoint     string
+	token        string
+	httpClient   *http.Client
+	retryMax     int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+	limiter      *rateLimiter
+
+	// jitter randomizes backoff, so that processes don't retry in sync.
+	jitter   *rand.Rand
+	jitterMu sync.Mutex
 
 	Test        TestClient
 	Environment EnvironmentClient
@@ -24,12 +45,20 @@ type Client struct {
 	Schedule    ScheduleClient
 	Step        StepClient
 	RemoteAgent RemoteAgentClient
+	TestRun     TestRunClient
+	Result      ResultClient
+	Team        TeamClient
+	Account     AccountClient
 }
 
 func NewClient(options ...ClientOption) *Client {
 	client := &Client{
-		endpoint:   DefaultEndpoint,
-		httpClient: &http.Client{},
+		endpoint:     DefaultEndpoint,
+		httpClient:   &http.Client{},
+		retryMax:     DefaultRetryMax,
+		retryWaitMin: DefaultRetryWaitMin,
+		retryWaitMax: DefaultRetryWaitMax,
+		jitter:       rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 
 	for _, option := range options {
@@ -43,6 +72,10 @@ func NewClient(options ...ClientOption) *Client {
 	client.Schedule = ScheduleClient{client: client}
 	client.Step = StepClient{client: client}
 	client.RemoteAgent = RemoteAgentClient{client: client}
+	client.TestRun = TestRunClient{client: client}
+	client.Result = ResultClient{client: client}
+	client.Team = TeamClient{client: client}
+	client.Account = AccountClient{client: client}
 
 	return client
 }
@@ -61,8 +94,42 @@ func WithEndpoint(endpoint string) ClientOption {
 	}
 }
 
+// WithRateLimit limits the rate of requests made by the client
+// to rps requests per second with bursts of at most burst requests.
+// Non-positive rps disables limiting.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(client *Client) {
+		if rps <= 0 {
+			client.limiter = nil
+			return
+		}
+		client.limiter = newRateLimiter(rps, burst)
+	}
+}
+
+// WithRetryMax sets how many times a failed request is retried.
+// Zero disables retries.
+func WithRetryMax(retryMax int) ClientOption {
+	return func(client *Client) {
+		client.retryMax = retryMax
+	}
+}
+
+// WithRetryWait sets bounds of exponential backoff between retries.
+func WithRetryWait(min, max time.Duration) ClientOption {
+	return func(client *Client) {
+		client.retryWaitMin = min
+		client.retryWaitMax = max
+	}
+}
+
+// NewRequest returns request to the API path. Absolute URLs, like trigger
+// URLs, are requested as is, and the access token is sent only to the API.
 func (c *Client) NewRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
 	apiUrl := c.endpoint + path
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		apiUrl = path
+	}
 
 	req, err := func() (*http.Request, error) {
 		if body == nil {
@@ -80,7 +147,9 @@ func (c *Client) NewRequest(ctx context.Context, method, path string, body inter
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	if strings.HasPrefix(apiUrl, c.endpoint+"/") {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	}
 
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
@@ -91,23 +160,74 @@ func (c *Client) NewRequest(ctx context.Context, method, path string, body inter
 	return req, nil
 }
 
+// Do sends request and decodes response body into v.
+//
+// Requests failed with network error, 429 or 5xx status are retried
+// up to retryMax times. POST requests are retried only on 429, because
+// in other cases an entity may have been created already. Requests aren't
+// retried when Retry-After header asks to wait longer than retryWaitMax.
 func (c *Client) Do(r *http.Request, v interface{}) error {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && r.GetBody != nil {
+			body, err := r.GetBody()
+			if err != nil {
+				return err
+			}
+			r.Body = body
+		}
+
+		resp, body, err := c.do(r)
+		var wait time.Duration
+		retry := attempt < c.retryMax && c.shouldRetry(r, resp, err)
+		if retry {
+			wait, retry = c.backoff(attempt, resp)
+		}
+		if !retry {
+			if err != nil {
+				return err
+			}
+			return decodeResponse(resp, body, v)
+		}
+
+		select {
+		case <-r.Context().Done():
+			return r.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (c *Client) do(r *http.Request) (*http.Response, []byte, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(r.Context()); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	resp, err := c.httpClient.Do(r)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	body, err := ioutil.ReadAll(resp.Body)
 	defer resp.Body.Close()
 
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
+	return resp, body, nil
+}
+
+func decodeResponse(resp *http.Response, body []byte, v interface{}) error {
 	if resp.StatusCode >= 400 {
-		err = &Error{
+		err := &Error{
 			Response: resp,
 		}
+		if resp.Request != nil {
+			err.Method = resp.Request.Method
+			err.Path = resp.Request.URL.Path
+		}
 		json.Unmarshal(body, err)
 		return err
 	}
@@ -116,7 +236,98 @@ func (c *Client) Do(r *http.Request, v interface{}) error {
 		if err := json.Unmarshal(body, v); err != nil {
 			return err
 		}
+		logUnknownFields(resp, body, v)
 	}
 
 	return nil
 }
+
+// logUnknownFields logs response fields which aren't decoded into v,
+// so API additions could be noticed with TF_LOG=DEBUG. The body isn't
+// parsed again at other log levels.
+func logUnknownFields(resp *http.Response, body []byte, v interface{}) {
+	if !logging.IsDebugOrHigher() {
+		return
+	}
+
+	fields, err := schema.UnknownFields(body, v)
+	if err != nil {
+		return
+	}
+
+	var dropped []string
+	for _, field := range fields {
+		if field != "meta" && field != "error" {
+			dropped = append(dropped, field)
+		}
+	}
+	if len(dropped) == 0 {
+		return
+	}
+
+	log.Printf("[DEBUG] runscope: %s %s response fields not decoded into %T: %s",
+		resp.Request.Method, resp.Request.URL.Path, v, strings.Join(dropped, ", "))
+}
+
+func (c *Client) shouldRetry(r *http.Request, resp *http.Response, err error) bool {
+	if r.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return r.Method != http.MethodPost
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	return resp.StatusCode >= 500 && r.Method != http.MethodPost
+}
+
+// backoff returns delay before the next attempt and whether to retry.
+// Retry-After header takes precedence over exponential backoff with
+// jitter, which is limited by retryWaitMax. Retry-After longer than
+// retryWaitMax isn't waited for, the request fails instead.
+func (c *Client) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait, wait <= c.retryWaitMax
+		}
+	}
+
+	wait := c.retryWaitMin << uint(attempt)
+	if wait <= 0 || wait > c.retryWaitMax {
+		wait = c.retryWaitMax
+	}
+	if wait <= 0 {
+		return 0, true
+	}
+
+	c.jitterMu.Lock()
+	defer c.jitterMu.Unlock()
+	return wait/2 + time.Duration(c.jitter.Int63n(int64(wait/2)+1)), true
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
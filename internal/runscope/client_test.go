@@ -0,0 +1,160 @@
+package runscope
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_Do_retry(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"data": {"id": "1"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL), WithRetryWait(time.Millisecond, 10*time.Millisecond))
+
+	req, err := client.NewRequest(context.Background(), "PUT", "/", map[string]string{"name": "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Do(req, nil); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if len(bodies) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"name":"test"}` {
+			t.Errorf("attempt %d: unexpected body %q", i, body)
+		}
+	}
+}
+
+func TestClient_Do_retryMax(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL), WithRetryMax(2), WithRetryWait(time.Millisecond, time.Millisecond))
+
+	req, _ := client.NewRequest(context.Background(), "GET", "/", nil)
+	err := client.Do(req, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if e, ok := err.(*Error); !ok || e.Status() != http.StatusBadGateway {
+		t.Errorf("expected 502 error, got %s", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClient_Do_noRetryOfPost(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL), WithRetryWait(time.Millisecond, time.Millisecond))
+
+	req, _ := client.NewRequest(context.Background(), "POST", "/", nil)
+	if err := client.Do(req, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestClient_backoff(t *testing.T) {
+	client := NewClient(WithRetryWait(time.Second, 4*time.Second))
+
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		wait, ok := client.backoff(attempt, nil)
+		if !ok || wait < max/2 || wait > max {
+			t.Errorf("attempt %d: expected wait in [%s, %s], got %s", attempt, max/2, max, wait)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if wait, ok := client.backoff(0, resp); !ok || wait != 3*time.Second {
+		t.Errorf("expected Retry-After wait 3s, got %s", wait)
+	}
+
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+	if _, ok := client.backoff(0, resp); ok {
+		t.Error("expected no retry after Retry-After longer than 4s")
+	}
+}
+
+func TestClient_Do_retryAfterTooLong(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL), WithRetryWait(time.Millisecond, time.Second))
+
+	req, _ := client.NewRequest(context.Background(), "GET", "/", nil)
+	if err := client.Do(req, nil); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected rate limited error, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestLogUnknownFields(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+	defer os.Setenv("TF_LOG", os.Getenv("TF_LOG"))
+
+	req, _ := http.NewRequest("GET", "https://api.runscope.com/buckets", nil)
+	resp := &http.Response{Request: req}
+	var v struct {
+		Name string `json:"name"`
+	}
+	body := []byte(`{"name": "bucket", "color": "blue"}`)
+
+	for level, expected := range map[string]bool{"": false, "WARN": false, "DEBUG": true, "TRACE": true} {
+		os.Setenv("TF_LOG", level)
+		buf.Reset()
+		logUnknownFields(resp, body, &v)
+		if logged := strings.Contains(buf.String(), "color"); logged != expected {
+			t.Errorf("TF_LOG=%s: expected logged %t, got output %q", level, expected, buf.String())
+		}
+	}
+}
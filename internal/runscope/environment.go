@@ -188,6 +188,32 @@ func (c *EnvironmentClient) Get(ctx context.Context, opts *EnvironmentGetOpts) (
 	return EnvironmentFromSchema(&resp.Environment), err
 }
 
+type EnvironmentListOpts struct {
+	EnvironmentUriOpts
+}
+
+// List returns shared environments of the bucket, or environments
+// of the test if TestId is set.
+func (c *EnvironmentClient) List(ctx context.Context, opts *EnvironmentListOpts) ([]*Environment, error) {
+	req, err := c.client.NewRequest(ctx, "GET", opts.BaseURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.EnvironmentListResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	envs := make([]*Environment, len(resp.Environments))
+	for i := range resp.Environments {
+		envs[i] = EnvironmentFromSchema(&resp.Environments[i])
+	}
+
+	return envs, nil
+}
+
 type EnvironmentUpdateOpts struct {
 	EnvironmentGetOpts
 	EnvironmentBase
@@ -1,30 +1,82 @@
 package runscope
 
 import (
+	"errors"
 	"fmt"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
 	"net/http"
+	"strings"
 )
 
+// Errors matching API errors by status with errors.Is, e.g.
+//
+//	if errors.Is(err, runscope.ErrNotFound) {
+//		// the entity was deleted
+//	}
+var (
+	ErrNotFound     = errors.New("runscope: not found")
+	ErrUnauthorized = errors.New("runscope: unauthorized")
+	ErrForbidden    = errors.New("runscope: forbidden")
+	ErrRateLimited  = errors.New("runscope: rate limited")
+	ErrValidation   = errors.New("runscope: validation failed")
+)
+
+// Error is an error response of the API. Use errors.As to get it
+// from errors returned by the client.
 type Error struct {
 	Response *http.Response
-	E        struct {
-		Status  int    `json:"status"`
-		Message string `json:"message"`
-	} `json:"error"`
+	// Method and Path of the failed request.
+	Method string
+	Path   string
+	E      schema.Error `json:"error"`
 }
 
+// FieldError is a validation error of a request field.
+type FieldError = schema.FieldError
+
 func (e Error) Status() int {
 	if e.E.Status != 0 {
 		return e.E.Status
 	}
+	if e.Response == nil {
+		return 0
+	}
 	return e.Response.StatusCode
 }
 
 func (e Error) Error() string {
 	message := e.E.Message
-	if e.E.Message == "" {
+	if e.E.Message == "" && e.Response != nil {
 		message = e.Response.Status
 	}
 
-	return fmt.Sprintf("%d %s", e.Status(), message)
+	s := fmt.Sprintf("%d %s", e.Status(), message)
+	if len(e.E.Fields) > 0 {
+		fields := make([]string, len(e.E.Fields))
+		for i, f := range e.E.Fields {
+			fields[i] = f.Field + ": " + f.Message
+		}
+		s += " (" + strings.Join(fields, "; ") + ")"
+	}
+	if e.Method != "" {
+		s = fmt.Sprintf("%s %s: %s", e.Method, e.Path, s)
+	}
+	return s
+}
+
+// Is reports whether the error matches one of the status errors.
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status() == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.Status() == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.Status() == http.StatusForbidden
+	case ErrRateLimited:
+		return e.Status() == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.Status() == http.StatusBadRequest || e.Status() == http.StatusUnprocessableEntity
+	}
+	return false
 }
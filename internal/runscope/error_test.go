@@ -1,8 +1,12 @@
 package runscope
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -23,6 +27,78 @@ func TestError_Error(t *testing.T) {
 	}
 }
 
+func TestError_Is(t *testing.T) {
+	for status, target := range map[int]error{
+		404: ErrNotFound,
+		401: ErrUnauthorized,
+		403: ErrForbidden,
+		429: ErrRateLimited,
+		400: ErrValidation,
+	} {
+		err := fmt.Errorf("wrapped: %w", &Error{Response: &http.Response{StatusCode: status}})
+		if !errors.Is(err, target) {
+			t.Errorf("expected %d error to match %s", status, target)
+		}
+		if target != ErrNotFound && errors.Is(err, ErrNotFound) {
+			t.Errorf("expected %d error not to match %s", status, ErrNotFound)
+		}
+	}
+}
+
+func TestError_validation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(runscopeValidationResponse))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL), WithRetryMax(0))
+	req, _ := client.NewRequest(context.Background(), "POST", "/buckets/key/tests", map[string]string{})
+	err := client.Do(req, nil)
+
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *Error, got %T", err)
+	}
+	if !errors.Is(err, ErrValidation) {
+		t.Errorf("expected validation error, got %s", err)
+	}
+	if e.Method != "POST" || e.Path != "/buckets/key/tests" {
+		t.Errorf("unexpected request %s %s", e.Method, e.Path)
+	}
+	if e.E.MoreInfo != "https://www.runscope.com/docs/api/tests" {
+		t.Errorf("unexpected more_info %q", e.E.MoreInfo)
+	}
+	if len(e.E.Fields) != 1 || e.E.Fields[0].Field != "name" {
+		t.Errorf("unexpected field errors %+v", e.E.Fields)
+	}
+
+	expectedError := "POST /buckets/key/tests: 400 Invalid request (name: This field is required.)"
+	if err.Error() != expectedError {
+		t.Errorf("Expected %s error message, got %s", expectedError, err.Error())
+	}
+}
+
+const runscopeValidationResponse = `
+{
+  "data": {},
+  "meta": {
+    "status": "error"
+  },
+  "error": {
+    "status": 400,
+    "message": "Invalid request",
+    "more_info": "https://www.runscope.com/docs/api/tests",
+    "errors": [
+      {
+        "field": "name",
+        "message": "This field is required."
+      }
+    ]
+  }
+}
+`
+
 const runscopeInvalidTokenResponse = `
 {
   "data": {},
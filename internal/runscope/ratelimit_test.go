@@ -0,0 +1,52 @@
+package runscope
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter := newRateLimiter(50, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Two requests fit into the burst, the other two wait 20ms each.
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("expected limiter to delay requests, elapsed %s", elapsed)
+	}
+}
+
+func TestRateLimiter_reserve_fractionalRate(t *testing.T) {
+	limiter := newRateLimiter(0.5, 1)
+
+	if wait := limiter.reserve(); wait != 0 {
+		t.Fatalf("expected first request to pass, got wait %s", wait)
+	}
+
+	// A token per 2 seconds, half of it is refilled after a second.
+	limiter.last = limiter.last.Add(-time.Second)
+	wait := limiter.reserve()
+	if wait < 999*time.Millisecond || wait > time.Second {
+		t.Errorf("expected wait of 1s, got %s", wait)
+	}
+}
+
+func TestRateLimiter_Wait_canceled(t *testing.T) {
+	limiter := newRateLimiter(0.001, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("expected first request to pass, got %s", err)
+	}
+	if err := limiter.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
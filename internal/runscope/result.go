@@ -0,0 +1,183 @@
+package runscope
+
+import (
+	"context"
+	"fmt"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type ResultCounts struct {
+	AssertionsDefined int
+	AssertionsPassed  int
+	AssertionsFailed  int
+	ScriptsDefined    int
+	ScriptsPassed     int
+	ScriptsFailed     int
+	VariablesDefined  int
+	VariablesPassed   int
+	VariablesFailed   int
+}
+
+type ResultRequest struct {
+	ResultCounts
+	UUID   string
+	Method string
+	URL    string
+	Result string
+}
+
+type Result struct {
+	ResultCounts
+	TestRunId        string
+	TestId           string
+	BucketKey        string
+	EnvironmentId    string
+	EnvironmentName  string
+	Region           string
+	Result           string
+	StartedAt        time.Time
+	FinishedAt       time.Time
+	TestRunURL       string
+	RequestsExecuted int
+	Requests         []ResultRequest
+}
+
+// Finished reports whether the run is finished, i.e. it's neither queued
+// nor still running.
+func (r *Result) Finished() bool {
+	switch r.Result {
+	case "", "init", "queued", "working":
+		return false
+	}
+	return true
+}
+
+type ResultClient struct {
+	client *Client
+}
+
+func resultCountsFromSchema(s *schema.ResultCounts) ResultCounts {
+	return ResultCounts{
+		AssertionsDefined: s.AssertionsDefined,
+		AssertionsPassed:  s.AssertionsPassed,
+		AssertionsFailed:  s.AssertionsFailed,
+		ScriptsDefined:    s.ScriptsDefined,
+		ScriptsPassed:     s.ScriptsPassed,
+		ScriptsFailed:     s.ScriptsFailed,
+		VariablesDefined:  s.VariablesDefined,
+		VariablesPassed:   s.VariablesPassed,
+		VariablesFailed:   s.VariablesFailed,
+	}
+}
+
+func ResultFromSchema(s *schema.Result) *Result {
+	result := &Result{}
+	result.ResultCounts = resultCountsFromSchema(&s.ResultCounts)
+	result.TestRunId = s.TestRunId
+	result.TestId = s.TestId
+	result.BucketKey = s.BucketKey
+	result.EnvironmentId = s.EnvironmentId
+	result.EnvironmentName = s.EnvironmentName
+	result.Region = s.Region
+	result.Result = s.Result
+	result.StartedAt = unixSeconds(s.StartedAt)
+	result.FinishedAt = unixSeconds(s.FinishedAt)
+	result.TestRunURL = s.TestRunURL
+	result.RequestsExecuted = s.RequestsExecuted
+	result.Requests = make([]ResultRequest, len(s.Requests))
+	for i, r := range s.Requests {
+		result.Requests[i] = ResultRequest{
+			ResultCounts: resultCountsFromSchema(&r.ResultCounts),
+			UUID:         r.UUID,
+			Method:       r.Method,
+			URL:          r.URL,
+			Result:       r.Result,
+		}
+	}
+	return result
+}
+
+// unixSeconds converts fractional seconds since epoch to time.
+func unixSeconds(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
+
+type ResultGetOpts struct {
+	BucketId string
+	TestId   string
+	// Id is the test run ID.
+	Id string
+}
+
+func (opts *ResultGetOpts) URL() string {
+	return fmt.Sprintf("/buckets/%s/tests/%s/results/%s", opts.BucketId, opts.TestId, opts.Id)
+}
+
+func (c *ResultClient) Get(ctx context.Context, opts *ResultGetOpts) (*Result, error) {
+	req, err := c.client.NewRequest(ctx, "GET", opts.URL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.ResultGetResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return ResultFromSchema(&resp.Result), nil
+}
+
+type ResultListOpts struct {
+	BucketId string
+	TestId   string
+	// Count is the number of results to return, API defaults to 10
+	// and allows at most 50.
+	Count int
+	// Since limits results to runs started after the time.
+	Since time.Time
+	// Before limits results to runs started before the time.
+	Before time.Time
+}
+
+func (opts *ResultListOpts) URL() string {
+	query := url.Values{}
+	if opts.Count > 0 {
+		query.Set("count", strconv.Itoa(opts.Count))
+	}
+	if !opts.Since.IsZero() {
+		query.Set("since", strconv.FormatInt(opts.Since.Unix(), 10))
+	}
+	if !opts.Before.IsZero() {
+		query.Set("before", strconv.FormatInt(opts.Before.Unix(), 10))
+	}
+	if len(query) == 0 {
+		return fmt.Sprintf("/buckets/%s/tests/%s/results", opts.BucketId, opts.TestId)
+	}
+	return fmt.Sprintf("/buckets/%s/tests/%s/results?%s", opts.BucketId, opts.TestId, query.Encode())
+}
+
+// List returns the latest results of the test, most recent first.
+// Results in the list don't include requests.
+func (c *ResultClient) List(ctx context.Context, opts *ResultListOpts) ([]*Result, error) {
+	req, err := c.client.NewRequest(ctx, "GET", opts.URL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.ResultListResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*Result, len(resp.Results))
+	for i := range resp.Results {
+		results[i] = ResultFromSchema(&resp.Results[i])
+	}
+
+	return results, nil
+}
@@ -91,6 +91,30 @@ func (c *ScheduleClient) Get(ctx context.Context, opts *ScheduleGetOpts) (*Sched
 	return ScheduleFromSchema(&resp.Schedule), err
 }
 
+type ScheduleListOpts struct {
+	ScheduleURLOpts
+}
+
+func (c *ScheduleClient) List(ctx context.Context, opts *ScheduleListOpts) ([]*Schedule, error) {
+	req, err := c.client.NewRequest(ctx, "GET", opts.URL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.ScheduleListResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*Schedule, len(resp.Schedules))
+	for i := range resp.Schedules {
+		schedules[i] = ScheduleFromSchema(&resp.Schedules[i])
+	}
+
+	return schedules, nil
+}
+
 type ScheduleUpdateOpts struct {
 	ScheduleGetOpts
 	ScheduleBase
@@ -23,6 +23,15 @@ type BucketGetResponse struct {
 	Bucket `json:"data"`
 }
 
+type BucketUpdateRequest struct {
+	Name      string `json:"name"`
+	VerifySSL bool   `json:"verify_ssl"`
+}
+
+type BucketUpdateResponse struct {
+	Bucket `json:"data"`
+}
+
 type BucketListResponse struct {
 	Buckets []Bucket `json:"data"`
 }
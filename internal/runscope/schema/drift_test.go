@@ -0,0 +1,210 @@
+package schema
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// envelopeFields are common fields of all API responses, which aren't
+// decoded into response types. Fields of error are checked against Error.
+var envelopeFields = map[string]bool{"meta": true}
+
+var testDroppedFields = []string{
+	"data.environments[].auth",
+	"data.environments[].exported_at",
+	"data.environments[].headers",
+	"data.environments[].test_id",
+	"data.environments[].version",
+	"data.exported_at",
+	"data.schedules[].version",
+	"data.version",
+}
+
+var environmentDroppedFields = []string{
+	"data.auth",
+	"data.exported_at",
+	"data.headers",
+	"data.test_id",
+	"data.version",
+}
+
+var bucketDroppedFields = []string{
+	"data.collections_url",
+	"data.messages_url",
+	"data.tests_url",
+}
+
+// schemaDriftFixtures maps recorded API responses in testdata to the types
+// they are decoded into, and lists response fields known to be dropped.
+// When a fixture is re-recorded and the test fails, either model the new
+// fields or add them to the list of dropped fields.
+var schemaDriftFixtures = []struct {
+	fixture string
+	target  func() interface{}
+	dropped []string
+}{
+	{"bucket_create", func() interface{} { return &BucketCreateResponse{} }, bucketDroppedFields},
+	{"bucket_get", func() interface{} { return &BucketGetResponse{} }, bucketDroppedFields},
+	{"bucket_update", func() interface{} { return &BucketUpdateResponse{} }, bucketDroppedFields},
+	{"bucket_list", func() interface{} { return &BucketListResponse{} }, []string{
+		"data[].collections_url",
+		"data[].messages_url",
+		"data[].tests_url",
+	}},
+	{"test_create", func() interface{} { return &TestCreateResponse{} }, testDroppedFields},
+	{"test_get", func() interface{} { return &TestGetResponse{} }, testDroppedFields},
+	{"test_update", func() interface{} { return &TestUpdateResponse{} }, testDroppedFields},
+	{"test_list", func() interface{} { return &TestListResponse{} }, []string{
+		"data[].last_run.bucket_key",
+		"data[].last_run.remote_agent_name",
+		"data[].last_run.remote_agent_uuid",
+		"data[].last_run.remote_agent_version",
+		"data[].last_run.test_id",
+		"data[].last_run.uuid",
+	}},
+	{"step_create", func() interface{} { return &StepCreateResponse{} }, nil},
+	{"step_get", func() interface{} { return &StepGetResponse{} }, nil},
+	{"step_update", func() interface{} { return &StepUpdateResponse{} }, nil},
+	{"step_reorder", func() interface{} { return &StepReorderResponse{} }, nil},
+	{"environment_create", func() interface{} { return &EnvironmentCreateResponse{} }, environmentDroppedFields},
+	{"environment_get", func() interface{} { return &EnvironmentGetResponse{} }, environmentDroppedFields},
+	{"environment_update", func() interface{} { return &EnvironmentUpdateResponse{} }, environmentDroppedFields},
+	{"environment_list", func() interface{} { return &EnvironmentListResponse{} }, []string{
+		"data[].auth",
+		"data[].exported_at",
+		"data[].headers",
+		"data[].test_id",
+		"data[].version",
+	}},
+	{"schedule_create", func() interface{} { return &ScheduleCreateResponse{} }, []string{"data.version"}},
+	{"schedule_get", func() interface{} { return &ScheduleGetResponse{} }, []string{"data.version"}},
+	{"schedule_update", func() interface{} { return &ScheduleUpdateResponse{} }, []string{"data.version"}},
+	{"schedule_list", func() interface{} { return &ScheduleListResponse{} }, []string{"data[].version"}},
+	{"test_run_trigger", func() interface{} { return &TestRunTriggerResponse{} }, nil},
+	{"result_get", func() interface{} { return &ResultGetResponse{} }, []string{"data.agent"}},
+	{"result_list", func() interface{} { return &ResultListResponse{} }, []string{"data[].agent", "data[].agent_expired"}},
+	{"integration_list", func() interface{} { return &IntegrationListResponse{} }, nil},
+	{"account_get", func() interface{} { return &AccountGetResponse{} }, nil},
+	{"team_member_list", func() interface{} { return &TeamMemberListResponse{} }, nil},
+	{"remote_agent_list", func() interface{} { return &RemoteAgentListResponse{} }, nil},
+	{"error_validation", func() interface{} { return &ErrorResponse{} }, []string{"data"}},
+}
+
+func TestSchemaDrift(t *testing.T) {
+	for _, tc := range schemaDriftFixtures {
+		t.Run(tc.fixture, func(t *testing.T) {
+			data, err := ioutil.ReadFile(filepath.Join("testdata", tc.fixture+".json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			target := tc.target()
+			if err := json.Unmarshal(data, target); err != nil {
+				t.Fatalf("couldn't decode fixture into %T: %s", target, err)
+			}
+
+			unknown, err := UnknownFields(data, target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			unknown, err = checkErrorFields(data, unknown)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dropped := map[string]bool{}
+			for _, field := range tc.dropped {
+				dropped[field] = true
+			}
+
+			for _, field := range unknown {
+				if envelopeFields[field] {
+					continue
+				}
+				if !dropped[field] {
+					t.Errorf("field %s isn't modeled by %T", field, target)
+				}
+				delete(dropped, field)
+			}
+
+			for field := range dropped {
+				t.Errorf("field %s is expected to be dropped, but it's decoded by %T now", field, target)
+			}
+		})
+	}
+}
+
+// checkErrorFields replaces error field, which isn't decoded into response
+// types, with fields of error unknown to Error.
+func checkErrorFields(data []byte, unknown []string) ([]string, error) {
+	var fields []string
+	for _, field := range unknown {
+		if field != "error" {
+			fields = append(fields, field)
+			continue
+		}
+
+		errorUnknown, err := UnknownFields(data, &ErrorResponse{})
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range errorUnknown {
+			if strings.HasPrefix(f, "error.") {
+				fields = append(fields, f)
+			}
+		}
+	}
+	return fields, nil
+}
+
+func TestUnknownFields(t *testing.T) {
+	type nested struct {
+		Id string `json:"id"`
+	}
+	type base struct {
+		Name string `json:"name"`
+	}
+	type target struct {
+		base
+		Items  []nested            `json:"items"`
+		Map    map[string]nested   `json:"map"`
+		Raw    json.RawMessage     `json:"raw"`
+		Any    interface{}         `json:"any"`
+		Ignore string              `json:"-"`
+		Ptr    *nested             `json:"ptr"`
+		Groups map[string][]string `json:"groups"`
+
+		Assertions []StepAssertion `json:"assertions"`
+	}
+
+	data := []byte(`{
+		"name": "n",
+		"extra": 1,
+		"Ignore": "x",
+		"items": [{"id": "1", "more": true}],
+		"map": {"a": {"id": "2", "other": null}},
+		"raw": {"anything": 1},
+		"any": {"anything": 1},
+		"ptr": {"id": "3", "deep": {}},
+		"groups": {"a": ["b"]},
+		"assertions": [{"source": "response_status", "comparison": "equal", "value": 200, "unit": "ms"}]
+	}`)
+
+	unknown, err := UnknownFields(data, &target{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Ignore", "assertions[].unit", "extra", "items[].more", "map{}.other", "ptr.deep"}
+	if len(unknown) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, unknown)
+	}
+	for i := range expected {
+		if unknown[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, unknown)
+		}
+	}
+}
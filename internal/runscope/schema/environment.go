@@ -50,6 +50,10 @@ type EnvironmentGetResponse struct {
 	Environment `json:"data"`
 }
 
+type EnvironmentListResponse struct {
+	Environments []Environment `json:"data"`
+}
+
 type EnvironmentCreateRequest struct {
 	EnvironmentBase
 }
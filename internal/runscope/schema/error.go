@@ -0,0 +1,21 @@
+package schema
+
+// Error is the error of failed request.
+type Error struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	// MoreInfo is a link to the documentation of the error.
+	MoreInfo string `json:"more_info"`
+	// Fields are validation errors of 400 responses.
+	Fields []FieldError `json:"errors"`
+}
+
+// FieldError is a validation error of a request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type ErrorResponse struct {
+	Error Error `json:"error"`
+}
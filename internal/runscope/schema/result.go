@@ -0,0 +1,47 @@
+package schema
+
+type ResultCounts struct {
+	AssertionsDefined int `json:"assertions_defined"`
+	AssertionsPassed  int `json:"assertions_passed"`
+	AssertionsFailed  int `json:"assertions_failed"`
+	ScriptsDefined    int `json:"scripts_defined"`
+	ScriptsPassed     int `json:"scripts_passed"`
+	ScriptsFailed     int `json:"scripts_failed"`
+	VariablesDefined  int `json:"variables_defined"`
+	VariablesPassed   int `json:"variables_passed"`
+	VariablesFailed   int `json:"variables_failed"`
+}
+
+type ResultRequest struct {
+	ResultCounts
+	UUID   string `json:"uuid"`
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Result string `json:"result"`
+}
+
+// Result is a result of a test run. Times are fractional seconds,
+// FinishedAt is null until the run is finished.
+type Result struct {
+	ResultCounts
+	TestRunId        string          `json:"test_run_id"`
+	TestId           string          `json:"test_id"`
+	BucketKey        string          `json:"bucket_key"`
+	EnvironmentId    string          `json:"environment_id"`
+	EnvironmentName  string          `json:"environment_name"`
+	Region           string          `json:"region"`
+	Result           string          `json:"result"`
+	StartedAt        float64         `json:"started_at"`
+	FinishedAt       float64         `json:"finished_at"`
+	TestRunURL       string          `json:"test_run_url"`
+	RequestsExecuted int             `json:"requests_executed"`
+	Requests         []ResultRequest `json:"requests"`
+}
+
+type ResultGetResponse struct {
+	Result `json:"data"`
+}
+
+type ResultListResponse struct {
+	Results []Result `json:"data"`
+}
@@ -16,6 +16,10 @@ type ScheduleGetResponse struct {
 	Schedule `json:"data"`
 }
 
+type ScheduleListResponse struct {
+	Schedules []Schedule `json:"data"`
+}
+
 type ScheduleCreateRequest struct {
 	ScheduleBase
 }
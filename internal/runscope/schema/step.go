@@ -1,5 +1,7 @@
 package schema
 
+import "encoding/json"
+
 type StepBase struct {
 	StepType      string              `json:"step_type"`
 	Method        string              `json:"method"`
@@ -10,10 +12,33 @@ type StepBase struct {
 	Auth          StepAuth            `json:"auth"`
 	Body          string              `json:"body"`
 	Form          map[string][]string `json:"form"`
+	MultipartForm []StepMultipartPart `json:"multipart_form"`
 	Scripts       []string            `json:"scripts"`
 	BeforeScripts []string            `json:"before_scripts"`
 	Note          string              `json:"note"`
 	Skipped       bool                `json:"skipped"`
+
+	// pause steps
+	Duration int `json:"duration,omitempty"`
+
+	// condition steps
+	LeftValue  string `json:"left_value,omitempty"`
+	Comparison string `json:"comparison,omitempty"`
+	RightValue string `json:"right_value,omitempty"`
+	// Steps is nil for steps of other types. It's a pointer, so that
+	// empty list is sent to remove all nested steps of condition step.
+	Steps *[]Step `json:"steps,omitempty"`
+
+	// subtest steps
+	TestUUID        string      `json:"test_uuid,omitempty"`
+	EnvironmentUUID string      `json:"environment_uuid,omitempty"`
+	BucketKey       string      `json:"bucket_key,omitempty"`
+	Params          []StepParam `json:"params,omitempty"`
+
+	// ghost inspector steps
+	IntegrationId string `json:"integration_id,omitempty"`
+	GhostTestId   string `json:"ghost_test_id,omitempty"`
+	StartURL      string `json:"start_url,omitempty"`
 }
 
 type Step struct {
@@ -34,6 +59,41 @@ type StepAssertion struct {
 	Value      string `json:"value"`
 }
 
+// UnmarshalJSON decodes assertion accepting a value of any JSON type,
+// e.g. API returns numbers for assertions created in the web interface.
+func (a *StepAssertion) UnmarshalJSON(data []byte) error {
+	type stepAssertion StepAssertion
+	var v struct {
+		stepAssertion
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*a = StepAssertion(v.stepAssertion)
+	if len(v.Value) == 0 || string(v.Value) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(v.Value, &a.Value); err != nil {
+		a.Value = string(v.Value)
+	}
+	return nil
+}
+
+type StepMultipartPart struct {
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+	Type        string `json:"type,omitempty"`
+	Filename    string `json:"filename,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+}
+
+type StepParam struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
 type StepAuth struct {
 	Username string `json:"username,omitempty"`
 	Password string `json:"password,omitempty"`
@@ -59,3 +119,13 @@ type StepUpdateRequest struct {
 type StepUpdateResponse struct {
 	Step Step `json:"data"`
 }
+
+type StepReorderItem struct {
+	Id string `json:"id"`
+}
+
+type StepReorderRequest []StepReorderItem
+
+type StepReorderResponse struct {
+	Steps []Step `json:"data"`
+}
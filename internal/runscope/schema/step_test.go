@@ -78,3 +78,75 @@ const runscopeStepCreateResponse = `
   "error": null
 }
 `
+
+func TestStepAssertion_UnmarshalJSON(t *testing.T) {
+	for data, expected := range map[string]string{
+		`{"source": "response_status", "comparison": "equal_number", "value": 200}`:   "200",
+		`{"source": "response_status", "comparison": "equal_number", "value": "200"}`: "200",
+		`{"source": "response_json", "comparison": "equal", "value": true}`:           "true",
+		`{"source": "response_json", "comparison": "is_null", "value": null}`:         "",
+		`{"source": "response_json", "comparison": "not_empty"}`:                      "",
+	} {
+		var a StepAssertion
+		if err := json.Unmarshal([]byte(data), &a); err != nil {
+			t.Errorf("%s: %s", data, err)
+			continue
+		}
+		if a.Value != expected {
+			t.Errorf("%s: expected value %q, got %q", data, expected, a.Value)
+		}
+		if a.Source == "" || a.Comparison == "" {
+			t.Errorf("%s: expected source and comparison to be decoded, got %+v", data, a)
+		}
+	}
+}
+
+func TestStep_types(t *testing.T) {
+	var steps []Step
+	err := json.Unmarshal([]byte(`[
+		{"id": "1", "step_type": "pause", "duration": 5},
+		{"id": "2", "step_type": "condition", "left_value": "{{status}}", "comparison": "equal_number", "right_value": "200",
+		 "steps": [{"id": "3", "step_type": "request", "method": "GET", "url": "https://example.com"}]},
+		{"id": "4", "step_type": "subtest", "test_uuid": "t", "environment_uuid": "e", "bucket_key": "b",
+		 "params": [{"name": "token", "value": "{{token}}"}]},
+		{"id": "5", "step_type": "ghost", "integration_id": "i", "ghost_test_id": "g", "start_url": "https://example.com"}
+	]`), &steps)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if steps[0].Duration != 5 {
+		t.Errorf("expected pause duration 5, got %d", steps[0].Duration)
+	}
+	if steps[1].LeftValue != "{{status}}" || steps[1].Steps == nil || len(*steps[1].Steps) != 1 || (*steps[1].Steps)[0].URL != "https://example.com" {
+		t.Errorf("unexpected condition step %+v", steps[1])
+	}
+	if steps[2].TestUUID != "t" || len(steps[2].Params) != 1 || steps[2].Params[0].Value != "{{token}}" {
+		t.Errorf("unexpected subtest step %+v", steps[2])
+	}
+	if steps[3].IntegrationId != "i" || steps[3].GhostTestId != "g" {
+		t.Errorf("unexpected ghost inspector step %+v", steps[3])
+	}
+}
+
+func TestStep_multipartForm(t *testing.T) {
+	var step Step
+	err := json.Unmarshal([]byte(`{
+		"id": "1",
+		"step_type": "request",
+		"multipart_form": [
+			{"name": "title", "value": "report"},
+			{"name": "file", "value": "a,b", "type": "file", "filename": "report.csv", "content_type": "text/csv"}
+		]
+	}`), &step)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(step.MultipartForm) != 2 {
+		t.Fatalf("expected 2 multipart form parts, got %d", len(step.MultipartForm))
+	}
+	if part := step.MultipartForm[1]; part.Type != "file" || part.Filename != "report.csv" || part.ContentType != "text/csv" {
+		t.Errorf("unexpected file part %+v", part)
+	}
+}
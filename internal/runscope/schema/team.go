@@ -0,0 +1,32 @@
+package schema
+
+// Account is the user the access token belongs to.
+type Account struct {
+	Id        string        `json:"id"`
+	UUID      string        `json:"uuid"`
+	Name      string        `json:"name"`
+	Email     string        `json:"email"`
+	CreatedAt int64         `json:"created_at"`
+	Teams     []AccountTeam `json:"teams"`
+}
+
+type AccountTeam struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type AccountGetResponse struct {
+	Account `json:"data"`
+}
+
+type TeamMember struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	CreatedAt   int64  `json:"created_at"`
+	LastLoginAt int64  `json:"last_login_at"`
+}
+
+type TeamMemberListResponse struct {
+	Members []TeamMember `json:"data"`
+}
@@ -12,15 +12,28 @@ type TestBase struct {
 
 type Test struct {
 	TestBase
-	Id         string     `json:"id"`
-	Steps      []TestStep `json:"steps"`
-	CreatedAt  int64      `json:"created_at"`
-	CreatedBy  CreatedBy  `json:"created_by"`
-	TriggerURL string     `json:"trigger_url"`
+	Id           string        `json:"id"`
+	Steps        []Step        `json:"steps"`
+	Environments []Environment `json:"environments"`
+	Schedules    []Schedule    `json:"schedules"`
+	CreatedAt    int64         `json:"created_at"`
+	CreatedBy    CreatedBy     `json:"created_by"`
+	LastRun      *TestLastRun  `json:"last_run"`
+	TriggerURL   string        `json:"trigger_url"`
 }
 
-type TestStep struct {
-	Id string `json:"id"`
+// TestLastRun is a summary of the latest run of the test, it's null
+// for tests which have never been run. Times are fractional seconds.
+type TestLastRun struct {
+	Id              string  `json:"id"`
+	Status          string  `json:"status"`
+	CreatedAt       float64 `json:"created_at"`
+	FinishedAt      float64 `json:"finished_at"`
+	EnvironmentId   string  `json:"environment_id"`
+	EnvironmentName string  `json:"environment_name"`
+	Region          string  `json:"region"`
+	TestRunId       string  `json:"test_run_id"`
+	TestRunURL      string  `json:"test_run_url"`
 }
 
 type CreatedBy struct {
@@ -33,6 +46,10 @@ type TestGetResponse struct {
 	Test `json:"data"`
 }
 
+type TestListResponse struct {
+	Tests []Test `json:"data"`
+}
+
 type TestCreateRequest struct {
 	TestMinimal
 }
@@ -0,0 +1,29 @@
+package schema
+
+// TestRun is a run of a test started by a trigger URL.
+type TestRun struct {
+	TestRunId       string            `json:"test_run_id"`
+	TestId          string            `json:"test_id"`
+	TestName        string            `json:"test_name"`
+	BucketKey       string            `json:"bucket_key"`
+	EnvironmentId   string            `json:"environment_id"`
+	EnvironmentName string            `json:"environment_name"`
+	Region          string            `json:"region"`
+	Status          string            `json:"status"`
+	Agent           string            `json:"agent"`
+	Variables       map[string]string `json:"variables"`
+	TestURL         string            `json:"test_url"`
+	TestRunURL      string            `json:"test_run_url"`
+	URL             string            `json:"url"`
+}
+
+type TestRunTrigger struct {
+	Runs        []TestRun `json:"runs"`
+	RunsFailed  int       `json:"runs_failed"`
+	RunsStarted int       `json:"runs_started"`
+	RunsTotal   int       `json:"runs_total"`
+}
+
+type TestRunTriggerResponse struct {
+	TestRunTrigger `json:"data"`
+}
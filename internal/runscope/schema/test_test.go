@@ -2,6 +2,8 @@ package schema
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 )
 
@@ -121,3 +123,31 @@ const runscopeTestCreateOkResponse = `{
         "status": "success"
     }
 }`
+
+func TestUnmarshallTestListResponse(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "test_list.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var resp TestListResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Tests) != 2 {
+		t.Fatalf("expected 2 tests, got %d", len(resp.Tests))
+	}
+
+	if resp.Tests[0].LastRun != nil {
+		t.Errorf("expected no last run of never run test, got %+v", resp.Tests[0].LastRun)
+	}
+
+	lastRun := resp.Tests[1].LastRun
+	if lastRun == nil {
+		t.Fatal("expected last run")
+	}
+	if lastRun.Status != "pass" || lastRun.CreatedAt != 1618313016.21 || lastRun.TestRunId != "f1c9a3d2-5b6e-4f7a-8c9d-0e1f2a3b4c5d" {
+		t.Errorf("unexpected last run %+v", lastRun)
+	}
+}
@@ -0,0 +1,126 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// UnknownFields decodes data as v would be decoded by json.Unmarshal
+// and returns paths of JSON object fields which have no counterpart in v,
+// i.e. fields silently dropped by decoding. Array elements are denoted
+// by "[]" and map values by "{}" in paths, e.g. "data.steps[].multipart_form".
+func UnknownFields(data []byte, v interface{}) ([]string, error) {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	found := map[string]bool{}
+	walkUnknown(raw, reflect.TypeOf(v), "", found)
+
+	fields := make([]string, 0, len(found))
+	for field := range found {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return fields, nil
+}
+
+func walkUnknown(raw interface{}, t reflect.Type, path string, found map[string]bool) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() == reflect.Interface || t == reflect.TypeOf(json.RawMessage{}) {
+		return
+	}
+	// Custom unmarshalers of structs, e.g. StepAssertion, decode into
+	// the struct fields, so only other types are opaque.
+	if t.Kind() != reflect.Struct && reflect.PtrTo(t).Implements(reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()) {
+		return
+	}
+
+	switch value := raw.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Map:
+			for _, v := range value {
+				walkUnknown(v, t.Elem(), path+"{}", found)
+			}
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for name, v := range value {
+				field, ok := lookupField(fields, name)
+				if !ok {
+					found[joinPath(path, name)] = true
+					continue
+				}
+				walkUnknown(v, field, joinPath(path, name), found)
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for _, v := range value {
+				walkUnknown(v, t.Elem(), path+"[]", found)
+			}
+		}
+	}
+}
+
+// jsonFields returns types of struct fields by their JSON names,
+// including fields promoted from embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for n, typ := range jsonFields(ft) {
+					if _, ok := fields[n]; !ok {
+						fields[n] = typ
+					}
+				}
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+func lookupField(fields map[string]reflect.Type, name string) (reflect.Type, bool) {
+	if t, ok := fields[name]; ok {
+		return t, true
+	}
+	for n, t := range fields {
+		if strings.EqualFold(n, name) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+	"strings"
 )
 
 type StepBase struct {
@@ -16,10 +17,29 @@ type StepBase struct {
 	Auth          StepAuth
 	Body          string
 	Form          map[string][]string
+	MultipartForm []StepMultipartPart
 	Scripts       []string
 	BeforeScripts []string
 	Note          string
 	Skipped       bool
+
+	Duration int
+
+	LeftValue  string
+	Comparison string
+	RightValue string
+
+	TestUUID        string
+	EnvironmentUUID string
+	BucketKey       string
+	Params          []StepParam
+
+	IntegrationId string
+	GhostTestId   string
+	StartURL      string
+
+	// Steps are nested steps of condition step.
+	Steps []Step
 }
 
 func (sb *StepBase) setFromSchema(s *schema.Step) {
@@ -36,10 +56,27 @@ func (sb *StepBase) setFromSchema(s *schema.Step) {
 	}
 	sb.Body = s.Body
 	sb.Form = map[string][]string{}
+	sb.MultipartForm = make([]StepMultipartPart, len(s.MultipartForm))
 	sb.Scripts = make([]string, len(s.Scripts))
 	sb.BeforeScripts = make([]string, len(s.BeforeScripts))
 	sb.Note = s.Note
 	sb.Skipped = s.Skipped
+	sb.Duration = s.Duration
+	sb.LeftValue = s.LeftValue
+	sb.Comparison = s.Comparison
+	sb.RightValue = s.RightValue
+	sb.TestUUID = s.TestUUID
+	sb.EnvironmentUUID = s.EnvironmentUUID
+	sb.BucketKey = s.BucketKey
+	sb.Params = make([]StepParam, len(s.Params))
+	sb.IntegrationId = s.IntegrationId
+	sb.GhostTestId = s.GhostTestId
+	sb.StartURL = s.StartURL
+	var steps []schema.Step
+	if s.Steps != nil {
+		steps = *s.Steps
+	}
+	sb.Steps = make([]Step, len(steps))
 
 	for i, v := range s.Variables {
 		sb.Variables[i] = StepVariable{
@@ -68,12 +105,30 @@ func (sb *StepBase) setFromSchema(s *schema.Step) {
 			sb.Form[name][i] = v
 		}
 	}
+	for i, p := range s.MultipartForm {
+		sb.MultipartForm[i] = StepMultipartPart{
+			Name:        p.Name,
+			Value:       p.Value,
+			Type:        p.Type,
+			Filename:    p.Filename,
+			ContentType: p.ContentType,
+		}
+	}
 	for i, s := range s.Scripts {
 		sb.Scripts[i] = s
 	}
 	for i, s := range s.BeforeScripts {
 		sb.BeforeScripts[i] = s
 	}
+	for i, p := range s.Params {
+		sb.Params[i] = StepParam{
+			Name:  p.Name,
+			Value: p.Value,
+		}
+	}
+	for i := range steps {
+		sb.Steps[i] = *StepFromSchema(&steps[i])
+	}
 }
 
 type Step struct {
@@ -94,6 +149,22 @@ type StepAssertion struct {
 	Value      string
 }
 
+// StepMultipartPart is a part of multipart/form-data request body.
+// Type is either "text" or "file"; Filename and ContentType
+// apply to file parts only.
+type StepMultipartPart struct {
+	Name        string
+	Value       string
+	Type        string
+	Filename    string
+	ContentType string
+}
+
+type StepParam struct {
+	Name  string
+	Value string
+}
+
 type StepAuth struct {
 	Username string
 	Password string
@@ -136,10 +207,29 @@ type StepBaseOpts struct {
 	Auth          StepAuth
 	Body          string
 	Form          map[string][]string
+	MultipartForm []StepMultipartPart
 	Scripts       []string
 	BeforeScripts []string
 	Note          string
 	Skipped       bool
+
+	Duration int
+
+	LeftValue  string
+	Comparison string
+	RightValue string
+
+	TestUUID        string
+	EnvironmentUUID string
+	BucketKey       string
+	Params          []StepParam
+
+	IntegrationId string
+	GhostTestId   string
+	StartURL      string
+
+	// Steps are nested steps of condition step.
+	Steps []StepBaseOpts
 }
 
 func (sbo *StepBaseOpts) setRequest(sb *schema.StepBase) {
@@ -156,10 +246,21 @@ func (sbo *StepBaseOpts) setRequest(sb *schema.StepBase) {
 	}
 	sb.Body = sbo.Body
 	sb.Form = map[string][]string{}
+	sb.MultipartForm = make([]schema.StepMultipartPart, len(sbo.MultipartForm))
 	sb.Scripts = make([]string, len(sbo.Scripts))
 	sb.BeforeScripts = make([]string, len(sbo.BeforeScripts))
 	sb.Note = sbo.Note
 	sb.Skipped = sbo.Skipped
+	sb.Duration = sbo.Duration
+	sb.LeftValue = sbo.LeftValue
+	sb.Comparison = sbo.Comparison
+	sb.RightValue = sbo.RightValue
+	sb.TestUUID = sbo.TestUUID
+	sb.EnvironmentUUID = sbo.EnvironmentUUID
+	sb.BucketKey = sbo.BucketKey
+	sb.IntegrationId = sbo.IntegrationId
+	sb.GhostTestId = sbo.GhostTestId
+	sb.StartURL = sbo.StartURL
 
 	for i, v := range sbo.Variables {
 		sb.Variables[i] = schema.StepVariable{
@@ -188,12 +289,39 @@ func (sbo *StepBaseOpts) setRequest(sb *schema.StepBase) {
 			sb.Form[name][i] = v
 		}
 	}
+	for i, p := range sbo.MultipartForm {
+		sb.MultipartForm[i] = schema.StepMultipartPart{
+			Name:        p.Name,
+			Value:       p.Value,
+			Type:        p.Type,
+			Filename:    p.Filename,
+			ContentType: p.ContentType,
+		}
+	}
 	for i, s := range sbo.Scripts {
 		sb.Scripts[i] = s
 	}
 	for i, s := range sbo.BeforeScripts {
 		sb.BeforeScripts[i] = s
 	}
+	if len(sbo.Params) > 0 {
+		sb.Params = make([]schema.StepParam, len(sbo.Params))
+		for i, p := range sbo.Params {
+			sb.Params[i] = schema.StepParam{
+				Name:  p.Name,
+				Value: p.Value,
+			}
+		}
+	}
+	// Nested steps of condition step are sent even if there are none,
+	// otherwise the API keeps the previous ones.
+	if sbo.StepType == "condition" || len(sbo.Steps) > 0 {
+		steps := make([]schema.Step, len(sbo.Steps))
+		for i := range sbo.Steps {
+			sbo.Steps[i].setRequest(&steps[i].StepBase)
+		}
+		sb.Steps = &steps
+	}
 }
 
 type StepCreateOpts struct {
@@ -201,7 +329,20 @@ type StepCreateOpts struct {
 	StepBaseOpts
 }
 
+// Create adds the step to the test. The API responds with all steps
+// of the test, so the created step is the one which wasn't there before.
+// Concurrent changes of steps of the same test make it ambiguous and
+// should be serialized by the caller.
 func (c *StepClient) Create(ctx context.Context, opts *StepCreateOpts) (*Step, error) {
+	test, err := c.client.Test.Get(ctx, TestGetOpts{BucketId: opts.BucketId, Id: opts.TestId})
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]bool{}
+	for _, step := range test.Steps {
+		existing[step.Id] = true
+	}
+
 	body := &schema.StepCreateRequest{}
 	opts.StepBaseOpts.setRequest(&body.StepBase)
 
@@ -216,7 +357,26 @@ func (c *StepClient) Create(ctx context.Context, opts *StepCreateOpts) (*Step, e
 		return nil, err
 	}
 
-	return StepFromSchema(&resp.Step[len(resp.Step)-1]), nil
+	var created []schema.Step
+	var ids []string
+	for _, step := range resp.Step {
+		if !existing[step.Id] {
+			created = append(created, step)
+			ids = append(ids, step.Id)
+		}
+	}
+	if len(created) == 0 {
+		return nil, fmt.Errorf("couldn't identify created step of test %s: no new steps found", opts.TestId)
+	}
+	// Steps created concurrently can't be told apart, and deleting them
+	// could delete a step of someone else, so they're reported to be
+	// imported or deleted by the user.
+	if len(created) > 1 {
+		return nil, fmt.Errorf("couldn't identify created step of test %s among new steps %s, import or delete them",
+			opts.TestId, strings.Join(ids, ", "))
+	}
+
+	return StepFromSchema(&created[0]), nil
 }
 
 type StepGetOpts struct {
@@ -284,3 +444,35 @@ func (c *StepClient) Delete(ctx context.Context, opts *StepDeleteOpts) error {
 
 	return nil
 }
+
+type StepReorderOpts struct {
+	StepUriOpts
+	// Ids are IDs of all steps of the test in the desired order.
+	Ids []string
+}
+
+// Reorder changes order of the test steps, returning steps in the new order.
+func (c *StepClient) Reorder(ctx context.Context, opts *StepReorderOpts) ([]Step, error) {
+	body := make(schema.StepReorderRequest, len(opts.Ids))
+	for i, id := range opts.Ids {
+		body[i].Id = id
+	}
+
+	req, err := c.client.NewRequest(ctx, "PUT", opts.URL(), &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.StepReorderResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]Step, len(resp.Steps))
+	for i := range resp.Steps {
+		steps[i] = *StepFromSchema(&resp.Steps[i])
+	}
+
+	return steps, nil
+}
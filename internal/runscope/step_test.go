@@ -0,0 +1,114 @@
+package runscope
+
+import (
+	"context"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscopetest"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestStepClient_Create(t *testing.T) {
+	for _, tc := range []struct {
+		created string
+		id      string
+	}{
+		// id is ID of the identified step, or IDs listed in the error.
+		{`[{"id": "b"}, {"id": "a"}]`, "b"},
+		{`[{"id": "a"}, {"id": "b"}, {"id": "c"}]`, "b, c"},
+		{`[{"id": "a"}]`, ""},
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case "GET":
+				w.Write([]byte(`{"data": {"id": "test", "steps": [{"id": "a"}]}}`))
+			case "POST":
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"data": ` + tc.created + `}`))
+			}
+		}))
+
+		client := NewClient(WithEndpoint(server.URL), WithRetryMax(0))
+		opts := &StepCreateOpts{StepUriOpts: StepUriOpts{BucketId: "bucket", TestId: "test"}}
+		step, err := client.Step.Create(context.Background(), opts)
+		server.Close()
+
+		if tc.id == "" || strings.Contains(tc.id, ",") {
+			if err == nil {
+				t.Errorf("%s: expected error, got step %s", tc.created, step.Id)
+			} else if !strings.Contains(err.Error(), tc.id) {
+				t.Errorf("%s: expected error listing %s, got %s", tc.created, tc.id, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", tc.created, err)
+		} else if step.Id != tc.id {
+			t.Errorf("%s: expected step %s, got %s", tc.created, tc.id, step.Id)
+		}
+	}
+}
+
+func TestStepClient_Create_concurrent(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	other := NewClient(WithEndpoint(server.URL), WithRetryMax(0))
+
+	bucket, err := other.Bucket.Create(ctx, &BucketCreateOpts{Name: "bucket", TeamUUID: "c8ffd67b-c281-45d3-9735-3f40ee567a02"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testOpts := TestCreateOpts{}
+	testOpts.BucketId = bucket.Key
+	testOpts.Name = "test"
+	test, err := other.Test.Create(ctx, testOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another client creates a step right before the request, after
+	// steps of the test were read.
+	var concurrent *Step
+	target, _ := url.Parse(server.URL)
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	front := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && concurrent == nil {
+			opts := &StepCreateOpts{StepUriOpts: StepUriOpts{BucketId: bucket.Key, TestId: test.Id}}
+			opts.StepType = "pause"
+			opts.Duration = 1
+			var err error
+			if concurrent, err = other.Step.Create(ctx, opts); err != nil {
+				t.Error(err)
+			}
+		}
+		proxy.ServeHTTP(w, r)
+	}))
+	defer front.Close()
+
+	client := NewClient(WithEndpoint(front.URL), WithRetryMax(0))
+	opts := &StepCreateOpts{StepUriOpts: StepUriOpts{BucketId: bucket.Key, TestId: test.Id}}
+	opts.StepType = "request"
+	opts.Method = "GET"
+	opts.StepURL = "https://example.com"
+	_, createErr := client.Step.Create(ctx, opts)
+	if createErr == nil {
+		t.Fatal("expected error")
+	}
+
+	test, err = other.Test.Get(ctx, TestGetOpts{BucketId: bucket.Key, Id: test.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(test.Steps) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(test.Steps))
+	}
+	for _, step := range test.Steps {
+		if !strings.Contains(createErr.Error(), step.Id) {
+			t.Errorf("expected error to list step %s, got %s", step.Id, createErr)
+		}
+	}
+}
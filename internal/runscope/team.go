@@ -0,0 +1,73 @@
+package runscope
+
+import (
+	"context"
+	"fmt"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+	"time"
+)
+
+type TeamMember struct {
+	Id          string
+	Name        string
+	Email       string
+	CreatedAt   time.Time
+	LastLoginAt time.Time
+}
+
+type TeamClient struct {
+	client *Client
+}
+
+func TeamMemberFromSchema(s *schema.TeamMember) *TeamMember {
+	return &TeamMember{
+		Id:          s.Id,
+		Name:        s.Name,
+		Email:       s.Email,
+		CreatedAt:   time.Unix(s.CreatedAt, 0),
+		LastLoginAt: time.Unix(s.LastLoginAt, 0),
+	}
+}
+
+// List returns teams the user of the access token is a member of.
+func (c *TeamClient) List(ctx context.Context) ([]*Team, error) {
+	account, err := c.client.Account.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]*Team, len(account.Teams))
+	for i := range account.Teams {
+		teams[i] = &account.Teams[i]
+	}
+
+	return teams, nil
+}
+
+type TeamMemberListOpts struct {
+	TeamId string
+}
+
+func (opts *TeamMemberListOpts) URL() string {
+	return fmt.Sprintf("/teams/%s/people", opts.TeamId)
+}
+
+func (c *TeamClient) ListMembers(ctx context.Context, opts *TeamMemberListOpts) ([]*TeamMember, error) {
+	req, err := c.client.NewRequest(ctx, "GET", opts.URL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.TeamMemberListResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*TeamMember, len(resp.Members))
+	for i := range resp.Members {
+		members[i] = TeamMemberFromSchema(&resp.Members[i])
+	}
+
+	return members, nil
+}
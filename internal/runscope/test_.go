@@ -2,8 +2,11 @@ package runscope
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -21,17 +24,15 @@ type Test struct {
 	TestMinimal
 	Id                   string
 	DefaultEnvironmentId string
-	Steps                []TestStep
+	Steps                []Step
+	Environments         []*Environment
+	Schedules            []*Schedule
 	CreatedAt            time.Time
 	CreatedBy            CreatedBy
 	LastRun              time.Time
 	TriggerURL           string
 }
 
-type TestStep struct {
-	Id string
-}
-
 type CreatedBy struct {
 	Id    string
 	Name  string
@@ -48,9 +49,17 @@ func TestFromSchema(s schema.Test) *Test {
 	test.Name = s.Name
 	test.Description = s.Description
 	test.DefaultEnvironmentId = s.DefaultEnvironmentId
-	test.Steps = make([]TestStep, len(s.Steps))
+	test.Steps = make([]Step, len(s.Steps))
 	for i, step := range s.Steps {
-		test.Steps[i].Id = step.Id
+		test.Steps[i] = *StepFromSchema(&step)
+	}
+	test.Environments = make([]*Environment, len(s.Environments))
+	for i := range s.Environments {
+		test.Environments[i] = EnvironmentFromSchema(&s.Environments[i])
+	}
+	test.Schedules = make([]*Schedule, len(s.Schedules))
+	for i := range s.Schedules {
+		test.Schedules[i] = ScheduleFromSchema(&s.Schedules[i])
 	}
 	test.CreatedAt = time.Unix(s.CreatedAt, 0)
 	test.CreatedBy = CreatedBy{
@@ -58,6 +67,10 @@ func TestFromSchema(s schema.Test) *Test {
 		Name:  s.CreatedBy.Name,
 		Email: s.CreatedBy.Email,
 	}
+	test.LastRun = time.Unix(0, 0)
+	if s.LastRun != nil {
+		test.LastRun = unixSeconds(s.LastRun.CreatedAt)
+	}
 	test.TriggerURL = s.TriggerURL
 	return test
 }
@@ -84,6 +97,76 @@ func (c *TestClient) Get(ctx context.Context, opts TestGetOpts) (*Test, error) {
 	return TestFromSchema(resp.Test), err
 }
 
+type TestListOpts struct {
+	BucketId string
+	// Count is the number of tests to return, API defaults to 10.
+	Count int
+	// Offset is the number of tests to skip.
+	Offset int
+}
+
+func (opts *TestListOpts) URL() string {
+	query := url.Values{}
+	if opts.Count > 0 {
+		query.Set("count", strconv.Itoa(opts.Count))
+	}
+	if opts.Offset > 0 {
+		query.Set("offset", strconv.Itoa(opts.Offset))
+	}
+	if len(query) == 0 {
+		return fmt.Sprintf("/buckets/%s/tests", opts.BucketId)
+	}
+	return fmt.Sprintf("/buckets/%s/tests?%s", opts.BucketId, query.Encode())
+}
+
+// List returns a page of tests of the bucket. Tests in the list
+// don't include steps, environments and schedules.
+func (c *TestClient) List(ctx context.Context, opts TestListOpts) ([]*Test, error) {
+	req, err := c.client.NewRequest(ctx, "GET", opts.URL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.TestListResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	tests := make([]*Test, len(resp.Tests))
+	for i, t := range resp.Tests {
+		tests[i] = TestFromSchema(t)
+	}
+
+	return tests, nil
+}
+
+// defaultTestsPageSize is the number of tests requested per page by ListAll,
+// when the page size isn't set.
+const defaultTestsPageSize = 50
+
+// ListAll returns all tests of the bucket starting from opts.Offset,
+// requesting them by pages of opts.Count tests.
+func (c *TestClient) ListAll(ctx context.Context, opts TestListOpts) ([]*Test, error) {
+	if opts.Count <= 0 {
+		opts.Count = defaultTestsPageSize
+	}
+
+	var tests []*Test
+	for {
+		page, err := c.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		tests = append(tests, page...)
+		if len(page) < opts.Count {
+			return tests, nil
+		}
+		opts.Offset += opts.Count
+	}
+}
+
 type TestCreateOpts struct {
 	TestMinimal
 	BucketId string
@@ -160,3 +243,109 @@ func (c *TestClient) Delete(ctx context.Context, opts TestDeleteOpts) error {
 
 	return nil
 }
+
+type TestExportOpts struct {
+	BucketId string
+	Id       string
+}
+
+// Export returns JSON document of the test in the format of Runscope test
+// export: the test with its steps, environments and schedules. Shared
+// environments used by the test as default or by schedules are included
+// into environments of the document. Documents are decoded without
+// a schema to keep attributes unknown to the client. Attributes which
+// change on every read or run of the test, e.g. last_run, are removed, so
+// the document is stable as long as the test isn't changed.
+func (c *TestClient) Export(ctx context.Context, opts TestExportOpts) ([]byte, error) {
+	test, err := c.getDocument(ctx, fmt.Sprintf("/buckets/%s/tests/%s", opts.BucketId, opts.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	environments, _ := test["environments"].([]interface{})
+	exported := map[string]bool{}
+	for _, env := range environments {
+		if e, ok := env.(map[string]interface{}); ok {
+			id, _ := e["id"].(string)
+			exported[id] = true
+		}
+	}
+
+	envIds := []string{}
+	if id, ok := test["default_environment_id"].(string); ok {
+		envIds = append(envIds, id)
+	}
+	schedules, _ := test["schedules"].([]interface{})
+	for _, schedule := range schedules {
+		if s, ok := schedule.(map[string]interface{}); ok {
+			if id, ok := s["environment_id"].(string); ok {
+				envIds = append(envIds, id)
+			}
+		}
+	}
+
+	for _, id := range envIds {
+		if id == "" || exported[id] {
+			continue
+		}
+		env, err := c.getDocument(ctx, fmt.Sprintf("/buckets/%s/environments/%s", opts.BucketId, id))
+		if err != nil {
+			return nil, err
+		}
+		environments = append(environments, env)
+		exported[id] = true
+	}
+	test["environments"] = environments
+
+	for _, key := range testExportVolatileFields {
+		delete(test, key)
+	}
+
+	return json.MarshalIndent(test, "", "  ")
+}
+
+// testExportVolatileFields are attributes of the test removed
+// from the export document.
+var testExportVolatileFields = []string{"id", "created_at", "exported_at", "last_run", "trigger_url", "version"}
+
+func (c *TestClient) getDocument(ctx context.Context, path string) (map[string]interface{}, error) {
+	req, err := c.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+type TestImportOpts struct {
+	BucketId string
+	// Document is JSON document in the format of Runscope test export.
+	Document []byte
+}
+
+// Import creates a test with steps, environments and schedules
+// from the document exported by Runscope or by Export.
+func (c *TestClient) Import(ctx context.Context, opts TestImportOpts) (*Test, error) {
+	req, err := c.client.NewRequest(ctx,
+		"POST", fmt.Sprintf("/buckets/%s/tests", opts.BucketId),
+		json.RawMessage(opts.Document))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.TestCreateResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return TestFromSchema(resp.Test), err
+}
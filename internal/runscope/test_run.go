@@ -0,0 +1,95 @@
+package runscope
+
+import (
+	"context"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+	"net/url"
+)
+
+// TestRun is a run of a test started by a trigger URL. Results of the run
+// are read by ResultClient.
+type TestRun struct {
+	TestRunId       string
+	TestId          string
+	TestName        string
+	BucketKey       string
+	EnvironmentId   string
+	EnvironmentName string
+	Region          string
+	Status          string
+	Variables       map[string]string
+	TestRunURL      string
+}
+
+type TestRunClient struct {
+	client *Client
+}
+
+func TestRunFromSchema(s *schema.TestRun) *TestRun {
+	return &TestRun{
+		TestRunId:       s.TestRunId,
+		TestId:          s.TestId,
+		TestName:        s.TestName,
+		BucketKey:       s.BucketKey,
+		EnvironmentId:   s.EnvironmentId,
+		EnvironmentName: s.EnvironmentName,
+		Region:          s.Region,
+		Status:          s.Status,
+		Variables:       s.Variables,
+		TestRunURL:      s.TestRunURL,
+	}
+}
+
+type TestRunTriggerOpts struct {
+	// TriggerURL is the trigger URL of a test or of a bucket.
+	TriggerURL string
+	// EnvironmentId overrides the default environment of the tests.
+	EnvironmentId string
+	// Variables override initial variables of the environment.
+	Variables map[string]string
+}
+
+func (opts *TestRunTriggerOpts) URL() (string, error) {
+	u, err := url.Parse(opts.TriggerURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	for name, value := range opts.Variables {
+		query.Set(name, value)
+	}
+	if opts.EnvironmentId != "" {
+		query.Set("runscope_environment", opts.EnvironmentId)
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// Trigger starts runs of the test, or of all tests of the bucket,
+// and returns them without waiting for results.
+func (c *TestRunClient) Trigger(ctx context.Context, opts *TestRunTriggerOpts) ([]*TestRun, error) {
+	triggerURL, err := opts.URL()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", triggerURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.TestRunTriggerResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*TestRun, len(resp.Runs))
+	for i := range resp.Runs {
+		runs[i] = TestRunFromSchema(&resp.Runs[i])
+	}
+
+	return runs, nil
+}
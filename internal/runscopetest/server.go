@@ -0,0 +1,1031 @@
+// Package runscopetest provides an in-memory fake of the Runscope API
+// for hermetic tests of the client and the provider.
+//
+// The fake implements the endpoints used by runscope.Client and keeps
+// all entities in memory. Point the client to it using
+// runscope.WithEndpoint(server.URL), or the provider using api_url.
+//
+// Triggered test runs are reported as running on the first read of their
+// results and finished on the next ones. Runs pass, unless they are
+// triggered with fake_result=fail variable.
+package runscopetest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+)
+
+// Server is a fake Runscope API server.
+type Server struct {
+	*httptest.Server
+
+	// Token, if not empty, is required in Authorization header of all requests.
+	Token string
+
+	mu           sync.Mutex
+	account      schema.Account
+	members      map[string][]schema.TeamMember
+	buckets      []*bucket
+	integrations map[string][]schema.Integration
+	remoteAgents map[string][]schema.RemoteAgent
+}
+
+type bucket struct {
+	schema.Bucket
+	tests        []*test
+	environments []*schema.Environment
+}
+
+type test struct {
+	schema.Test
+	steps        []*schema.Step
+	environments []*schema.Environment
+	schedules    []*schema.Schedule
+	results      []*result
+}
+
+// result is a result of a test run, which is reported as running
+// until it's read once.
+type result struct {
+	data    schema.Result
+	outcome string
+}
+
+// NewServer starts and returns a new fake server.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		account: schema.Account{
+			Name:      "Fake User",
+			Email:     "fake@example.com",
+			CreatedAt: time.Now().Unix(),
+			Teams:     []schema.AccountTeam{},
+		},
+		members:      map[string][]schema.TeamMember{},
+		integrations: map[string][]schema.Integration{},
+		remoteAgents: map[string][]schema.RemoteAgent{},
+	}
+	s.account.Id = newUUID()
+	s.account.UUID = s.account.Id
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// AddTeam adds the team to teams of the account.
+func (s *Server) AddTeam(team schema.AccountTeam) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.account.Teams = append(s.account.Teams, team)
+}
+
+// AddTeamMember adds the member to people of the team.
+func (s *Server) AddTeamMember(teamId string, member schema.TeamMember) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.members[teamId] = append(s.members[teamId], member)
+}
+
+// AddIntegration registers an integration available to the team.
+func (s *Server) AddIntegration(teamId string, integration schema.Integration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.integrations[teamId] = append(s.integrations[teamId], integration)
+}
+
+// AddRemoteAgent registers a remote agent connected to the team.
+func (s *Server) AddRemoteAgent(teamId string, agent schema.RemoteAgent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.remoteAgents[teamId] = append(s.remoteAgents[teamId], agent)
+}
+
+type request struct {
+	*http.Request
+	path []string
+}
+
+// match reports whether request path matches pattern, where "*"
+// matches any path segment.
+func (r *request) match(method string, pattern ...string) bool {
+	if r.Method != method || len(r.path) != len(pattern) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != r.path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Trigger URLs don't require authorization.
+	trigger := strings.HasPrefix(r.URL.Path, "/radar/")
+	if s.Token != "" && !trigger && r.Header.Get("Authorization") != "Bearer "+s.Token {
+		writeError(w, http.StatusUnauthorized, "You must provide a valid Authorization header to use the Runscope API.")
+		return
+	}
+
+	req := &request{Request: r, path: strings.Split(strings.Trim(r.URL.Path, "/"), "/")}
+	status, data := s.route(req)
+	if status >= 400 {
+		writeError(w, status, data.(string))
+		return
+	}
+
+	writeData(w, status, data)
+}
+
+func (s *Server) route(r *request) (int, interface{}) {
+	p := r.path
+	switch {
+	case r.match("GET", "buckets"):
+		return s.listBuckets()
+	case r.match("POST", "buckets"):
+		return s.createBucket(r)
+	case r.match("GET", "buckets", "*"):
+		return s.getBucket(p[1])
+	case r.match("PUT", "buckets", "*"):
+		return s.updateBucket(r, p[1])
+	case r.match("DELETE", "buckets", "*"):
+		return s.deleteBucket(p[1])
+
+	case r.match("GET", "buckets", "*", "environments"):
+		return s.listEnvironments(p[1], "")
+	case r.match("POST", "buckets", "*", "environments"):
+		return s.createEnvironment(r, p[1], "")
+	case r.match("GET", "buckets", "*", "environments", "*"):
+		return s.getEnvironment(p[1], "", p[3])
+	case r.match("PUT", "buckets", "*", "environments", "*"):
+		return s.updateEnvironment(r, p[1], "", p[3])
+	case r.match("DELETE", "buckets", "*", "environments", "*"):
+		return s.deleteEnvironment(p[1], "", p[3])
+
+	case r.match("GET", "buckets", "*", "tests"):
+		return s.listTests(r, p[1])
+	case r.match("POST", "buckets", "*", "tests"):
+		return s.createTest(r, p[1])
+	case r.match("GET", "buckets", "*", "tests", "*"):
+		return s.getTest(p[1], p[3])
+	case r.match("PUT", "buckets", "*", "tests", "*"):
+		return s.updateTest(r, p[1], p[3])
+	case r.match("DELETE", "buckets", "*", "tests", "*"):
+		return s.deleteTest(p[1], p[3])
+
+	case r.match("POST", "buckets", "*", "tests", "*", "steps"):
+		return s.createStep(r, p[1], p[3])
+	case r.match("PUT", "buckets", "*", "tests", "*", "steps"):
+		return s.reorderSteps(r, p[1], p[3])
+	case r.match("GET", "buckets", "*", "tests", "*", "steps", "*"):
+		return s.getStep(p[1], p[3], p[5])
+	case r.match("PUT", "buckets", "*", "tests", "*", "steps", "*"):
+		return s.updateStep(r, p[1], p[3], p[5])
+	case r.match("DELETE", "buckets", "*", "tests", "*", "steps", "*"):
+		return s.deleteStep(p[1], p[3], p[5])
+
+	case r.match("GET", "buckets", "*", "tests", "*", "environments"):
+		return s.listEnvironments(p[1], p[3])
+	case r.match("POST", "buckets", "*", "tests", "*", "environments"):
+		return s.createEnvironment(r, p[1], p[3])
+	case r.match("GET", "buckets", "*", "tests", "*", "environments", "*"):
+		return s.getEnvironment(p[1], p[3], p[5])
+	case r.match("PUT", "buckets", "*", "tests", "*", "environments", "*"):
+		return s.updateEnvironment(r, p[1], p[3], p[5])
+	case r.match("DELETE", "buckets", "*", "tests", "*", "environments", "*"):
+		return s.deleteEnvironment(p[1], p[3], p[5])
+
+	case r.match("GET", "buckets", "*", "tests", "*", "schedules"):
+		return s.listSchedules(p[1], p[3])
+	case r.match("POST", "buckets", "*", "tests", "*", "schedules"):
+		return s.createSchedule(r, p[1], p[3])
+	case r.match("GET", "buckets", "*", "tests", "*", "schedules", "*"):
+		return s.getSchedule(p[1], p[3], p[5])
+	case r.match("PUT", "buckets", "*", "tests", "*", "schedules", "*"):
+		return s.updateSchedule(r, p[1], p[3], p[5])
+	case r.match("DELETE", "buckets", "*", "tests", "*", "schedules", "*"):
+		return s.deleteSchedule(p[1], p[3], p[5])
+
+	case r.match("GET", "buckets", "*", "tests", "*", "results"):
+		return s.listResults(r, p[1], p[3])
+	case r.match("GET", "buckets", "*", "tests", "*", "results", "*"):
+		return s.getResult(p[1], p[3], p[5])
+
+	case r.match("POST", "radar", "*", "trigger"), r.match("GET", "radar", "*", "trigger"):
+		return s.triggerTest(r, s.URL+r.URL.Path)
+	case r.match("POST", "radar", "bucket", "*", "trigger"), r.match("GET", "radar", "bucket", "*", "trigger"):
+		return s.triggerBucket(r, s.URL+r.URL.Path)
+
+	case r.match("GET", "account"):
+		return http.StatusOK, s.account
+
+	case r.match("GET", "teams", "*", "people"):
+		return http.StatusOK, append([]schema.TeamMember{}, s.members[p[1]]...)
+	case r.match("GET", "teams", "*", "integrations"):
+		return http.StatusOK, append([]schema.Integration{}, s.integrations[p[1]]...)
+	case r.match("GET", "teams", "*", "agents"):
+		return http.StatusOK, append([]schema.RemoteAgent{}, s.remoteAgents[p[1]]...)
+	}
+
+	return http.StatusNotFound, "Not Found"
+}
+
+func (s *Server) listBuckets() (int, interface{}) {
+	buckets := make([]schema.Bucket, len(s.buckets))
+	for i, b := range s.buckets {
+		buckets[i] = b.Bucket
+	}
+	return http.StatusOK, buckets
+}
+
+func (s *Server) createBucket(r *request) (int, interface{}) {
+	query := r.URL.Query()
+	if query.Get("name") == "" || query.Get("team_uuid") == "" {
+		return http.StatusBadRequest, "name and team_uuid are required"
+	}
+
+	b := &bucket{}
+	b.Key = newKey()
+	b.Name = query.Get("name")
+	b.Team = schema.BucketTeam{Name: "Team", Id: query.Get("team_uuid")}
+	b.AuthToken = newUUID()
+	b.VerifySSL = true
+	// The first bucket of the team is its default bucket.
+	b.Default = true
+	for _, other := range s.buckets {
+		if other.Team.Id == b.Team.Id {
+			b.Default = false
+		}
+	}
+	b.TriggerURL = fmt.Sprintf("%s/radar/bucket/%s/trigger", s.URL, newUUID())
+	s.buckets = append(s.buckets, b)
+
+	return http.StatusCreated, b.Bucket
+}
+
+func (s *Server) getBucket(key string) (int, interface{}) {
+	b := s.findBucket(key)
+	if b == nil {
+		return http.StatusNotFound, "Bucket not found"
+	}
+	return http.StatusOK, b.Bucket
+}
+
+func (s *Server) updateBucket(r *request, key string) (int, interface{}) {
+	b := s.findBucket(key)
+	if b == nil {
+		return http.StatusNotFound, "Bucket not found"
+	}
+
+	var body schema.BucketUpdateRequest
+	if err := decodeBody(r, &body); err != nil {
+		return http.StatusBadRequest, err.Error()
+	}
+	if body.Name == "" {
+		return http.StatusBadRequest, "name is required"
+	}
+
+	// Fields missing in the request, e.g. default, are kept.
+	b.Name = body.Name
+	b.VerifySSL = body.VerifySSL
+	return http.StatusOK, b.Bucket
+}
+
+func (s *Server) deleteBucket(key string) (int, interface{}) {
+	for i, b := range s.buckets {
+		if b.Key == key {
+			s.buckets = append(s.buckets[:i], s.buckets[i+1:]...)
+			return http.StatusNoContent, nil
+		}
+	}
+	return http.StatusNotFound, "Bucket not found"
+}
+
+func (s *Server) findBucket(key string) *bucket {
+	for _, b := range s.buckets {
+		if b.Key == key {
+			return b
+		}
+	}
+	return nil
+}
+
+func (s *Server) findTest(bucketKey, testId string) *test {
+	b := s.findBucket(bucketKey)
+	if b == nil {
+		return nil
+	}
+	for _, t := range b.tests {
+		if t.Id == testId {
+			return t
+		}
+	}
+	return nil
+}
+
+// testData returns test as it's returned by the API.
+func (t *test) testData() schema.Test {
+	data := t.Test
+	data.Steps = make([]schema.Step, len(t.steps))
+	for i, step := range t.steps {
+		data.Steps[i] = *step
+	}
+	data.Environments = make([]schema.Environment, len(t.environments))
+	for i, env := range t.environments {
+		data.Environments[i] = *env
+	}
+	data.Schedules = make([]schema.Schedule, len(t.schedules))
+	for i, schedule := range t.schedules {
+		data.Schedules[i] = *schedule
+	}
+	return data
+}
+
+// testCreateRequest is a body of test create request, which is either
+// a name with description, or a test export document.
+type testCreateRequest struct {
+	schema.TestBase
+	Steps        []schema.Step        `json:"steps"`
+	Environments []schema.Environment `json:"environments"`
+	Schedules    []schema.Schedule    `json:"schedules"`
+}
+
+func (s *Server) createTest(r *request, bucketKey string) (int, interface{}) {
+	b := s.findBucket(bucketKey)
+	if b == nil {
+		return http.StatusNotFound, "Bucket not found"
+	}
+
+	var body testCreateRequest
+	if err := decodeBody(r, &body); err != nil {
+		return http.StatusBadRequest, err.Error()
+	}
+	if body.Name == "" {
+		return http.StatusBadRequest, "name is required"
+	}
+
+	t := &test{}
+	t.Id = newUUID()
+	t.Name = body.Name
+	t.Description = body.Description
+	t.CreatedAt = time.Now().Unix()
+	t.CreatedBy = schema.CreatedBy{Id: newUUID(), Name: "Grace Hopper", Email: "grace@example.com"}
+	t.TriggerURL = fmt.Sprintf("%s/radar/%s/trigger", s.URL, newUUID())
+
+	if len(body.Environments) == 0 {
+		env := &schema.Environment{Id: newUUID()}
+		env.Name = "Test Settings"
+		env.VerifySSL = true
+		env.Regions = []string{"us1"}
+		t.environments = append(t.environments, env)
+		t.DefaultEnvironmentId = env.Id
+	}
+
+	// Imported entities get new IDs, references to environments are updated.
+	envIds := map[string]string{}
+	for i := range body.Environments {
+		env := body.Environments[i]
+		envIds[env.Id] = newUUID()
+		env.Id = envIds[env.Id]
+		t.environments = append(t.environments, &env)
+	}
+	if id, ok := envIds[body.DefaultEnvironmentId]; ok {
+		t.DefaultEnvironmentId = id
+	} else if t.DefaultEnvironmentId == "" && len(t.environments) > 0 {
+		t.DefaultEnvironmentId = t.environments[0].Id
+	}
+	for i := range body.Schedules {
+		schedule := body.Schedules[i]
+		schedule.Id = newUUID()
+		if id, ok := envIds[schedule.EnvironmentId]; ok {
+			schedule.EnvironmentId = id
+		}
+		t.schedules = append(t.schedules, &schedule)
+	}
+	newStepIds(&body.Steps)
+	for i := range body.Steps {
+		t.steps = append(t.steps, &body.Steps[i])
+	}
+
+	b.tests = append(b.tests, t)
+
+	return http.StatusCreated, t.testData()
+}
+
+func (s *Server) listTests(r *request, bucketKey string) (int, interface{}) {
+	b := s.findBucket(bucketKey)
+	if b == nil {
+		return http.StatusNotFound, "Bucket not found"
+	}
+
+	count, offset := 10, 0
+	query := r.URL.Query()
+	if v := query.Get("count"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			count = n
+		}
+	}
+	if v := query.Get("offset"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			offset = n
+		}
+	}
+
+	tests := []schema.Test{}
+	for i := offset; i < len(b.tests) && i < offset+count; i++ {
+		tests = append(tests, b.tests[i].Test)
+	}
+	return http.StatusOK, tests
+}
+
+func (s *Server) getTest(bucketKey, testId string) (int, interface{}) {
+	t := s.findTest(bucketKey, testId)
+	if t == nil {
+		return http.StatusNotFound, "Test not found"
+	}
+	return http.StatusOK, t.testData()
+}
+
+func (s *Server) updateTest(r *request, bucketKey, testId string) (int, interface{}) {
+	t := s.findTest(bucketKey, testId)
+	if t == nil {
+		return http.StatusNotFound, "Test not found"
+	}
+
+	var body schema.TestUpdateRequest
+	if err := decodeBody(r, &body); err != nil {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	t.Name = body.Name
+	t.Description = body.Description
+	if body.DefaultEnvironmentId != "" {
+		t.DefaultEnvironmentId = body.DefaultEnvironmentId
+	}
+
+	return http.StatusOK, t.testData()
+}
+
+func (s *Server) deleteTest(bucketKey, testId string) (int, interface{}) {
+	b := s.findBucket(bucketKey)
+	if b == nil {
+		return http.StatusNotFound, "Bucket not found"
+	}
+	for i, t := range b.tests {
+		if t.Id == testId {
+			b.tests = append(b.tests[:i], b.tests[i+1:]...)
+			return http.StatusNoContent, nil
+		}
+	}
+	return http.StatusNotFound, "Test not found"
+}
+
+func (s *Server) createStep(r *request, bucketKey, testId string) (int, interface{}) {
+	t := s.findTest(bucketKey, testId)
+	if t == nil {
+		return http.StatusNotFound, "Test not found"
+	}
+
+	var body schema.StepCreateRequest
+	if err := decodeBody(r, &body); err != nil {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	assignStepIds(body.Steps)
+	t.steps = append(t.steps, &schema.Step{StepBase: body.StepBase, Id: newUUID()})
+
+	return http.StatusCreated, t.testData().Steps
+}
+
+func (s *Server) reorderSteps(r *request, bucketKey, testId string) (int, interface{}) {
+	t := s.findTest(bucketKey, testId)
+	if t == nil {
+		return http.StatusNotFound, "Test not found"
+	}
+
+	var body schema.StepReorderRequest
+	if err := decodeBody(r, &body); err != nil {
+		return http.StatusBadRequest, err.Error()
+	}
+	if len(body) != len(t.steps) {
+		return http.StatusBadRequest, "all steps of the test must be listed"
+	}
+
+	steps := make([]*schema.Step, len(body))
+	listed := map[string]bool{}
+	for i, item := range body {
+		_, j := s.findStep(bucketKey, testId, item.Id)
+		if j < 0 || listed[item.Id] {
+			return http.StatusBadRequest, fmt.Sprintf("step %s is unknown or listed twice", item.Id)
+		}
+		listed[item.Id] = true
+		steps[i] = t.steps[j]
+	}
+	t.steps = steps
+
+	return http.StatusOK, t.testData().Steps
+}
+
+func (s *Server) findStep(bucketKey, testId, stepId string) (*test, int) {
+	t := s.findTest(bucketKey, testId)
+	if t == nil {
+		return nil, -1
+	}
+	for i, step := range t.steps {
+		if step.Id == stepId {
+			return t, i
+		}
+	}
+	return t, -1
+}
+
+func (s *Server) getStep(bucketKey, testId, stepId string) (int, interface{}) {
+	t, i := s.findStep(bucketKey, testId, stepId)
+	if i < 0 {
+		return http.StatusNotFound, "Step not found"
+	}
+	return http.StatusOK, *t.steps[i]
+}
+
+func (s *Server) updateStep(r *request, bucketKey, testId, stepId string) (int, interface{}) {
+	t, i := s.findStep(bucketKey, testId, stepId)
+	if i < 0 {
+		return http.StatusNotFound, "Step not found"
+	}
+
+	var body schema.StepUpdateRequest
+	if err := decodeBody(r, &body); err != nil {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	// Nested steps are kept unless they are sent.
+	assignStepIds(body.Steps)
+	if body.Steps == nil {
+		body.Steps = t.steps[i].Steps
+	}
+	t.steps[i].StepBase = body.StepBase
+	return http.StatusOK, *t.steps[i]
+}
+
+// newStepIds assigns new IDs to imported steps and their nested steps.
+func newStepIds(steps *[]schema.Step) {
+	if steps == nil {
+		return
+	}
+	for i := range *steps {
+		(*steps)[i].Id = newUUID()
+		newStepIds((*steps)[i].Steps)
+	}
+}
+
+// assignStepIds assigns IDs to new nested steps of condition step.
+func assignStepIds(steps *[]schema.Step) {
+	if steps == nil {
+		return
+	}
+	for i := range *steps {
+		if (*steps)[i].Id == "" {
+			(*steps)[i].Id = newUUID()
+		}
+		assignStepIds((*steps)[i].Steps)
+	}
+}
+
+func (s *Server) deleteStep(bucketKey, testId, stepId string) (int, interface{}) {
+	t, i := s.findStep(bucketKey, testId, stepId)
+	if i < 0 {
+		return http.StatusNotFound, "Step not found"
+	}
+	t.steps = append(t.steps[:i], t.steps[i+1:]...)
+	return http.StatusNoContent, nil
+}
+
+// environments returns a pointer to environments list of a bucket
+// or of a test, if testId is not empty.
+func (s *Server) environments(bucketKey, testId string) *[]*schema.Environment {
+	if testId != "" {
+		if t := s.findTest(bucketKey, testId); t != nil {
+			return &t.environments
+		}
+		return nil
+	}
+	if b := s.findBucket(bucketKey); b != nil {
+		return &b.environments
+	}
+	return nil
+}
+
+func (s *Server) findEnvironment(bucketKey, testId, envId string) *schema.Environment {
+	envs := s.environments(bucketKey, testId)
+	if envs == nil {
+		return nil
+	}
+	for _, env := range *envs {
+		if env.Id == envId {
+			return env
+		}
+	}
+	return nil
+}
+
+func (s *Server) listEnvironments(bucketKey, testId string) (int, interface{}) {
+	envs := s.environments(bucketKey, testId)
+	if envs == nil {
+		return http.StatusNotFound, "Not Found"
+	}
+	data := make([]schema.Environment, len(*envs))
+	for i, env := range *envs {
+		data[i] = *env
+	}
+	return http.StatusOK, data
+}
+
+func (s *Server) createEnvironment(r *request, bucketKey, testId string) (int, interface{}) {
+	envs := s.environments(bucketKey, testId)
+	if envs == nil {
+		return http.StatusNotFound, "Not Found"
+	}
+
+	var body schema.EnvironmentCreateRequest
+	if err := decodeBody(r, &body); err != nil {
+		return http.StatusBadRequest, err.Error()
+	}
+	if body.Name == "" {
+		return http.StatusBadRequest, "name is required"
+	}
+
+	env := &schema.Environment{EnvironmentBase: body.EnvironmentBase, Id: newUUID()}
+	*envs = append(*envs, env)
+
+	return http.StatusCreated, *env
+}
+
+func (s *Server) getEnvironment(bucketKey, testId, envId string) (int, interface{}) {
+	env := s.findEnvironment(bucketKey, testId, envId)
+	if env == nil {
+		return http.StatusNotFound, "Environment not found"
+	}
+	return http.StatusOK, *env
+}
+
+func (s *Server) updateEnvironment(r *request, bucketKey, testId, envId string) (int, interface{}) {
+	env := s.findEnvironment(bucketKey, testId, envId)
+	if env == nil {
+		return http.StatusNotFound, "Environment not found"
+	}
+
+	var body schema.EnvironmentUpdateRequest
+	if err := decodeBody(r, &body); err != nil {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	env.EnvironmentBase = body.EnvironmentBase
+	return http.StatusOK, *env
+}
+
+func (s *Server) deleteEnvironment(bucketKey, testId, envId string) (int, interface{}) {
+	envs := s.environments(bucketKey, testId)
+	if envs == nil {
+		return http.StatusNotFound, "Environment not found"
+	}
+	for i, env := range *envs {
+		if env.Id == envId {
+			*envs = append((*envs)[:i], (*envs)[i+1:]...)
+			return http.StatusNoContent, nil
+		}
+	}
+	return http.StatusNotFound, "Environment not found"
+}
+
+func (s *Server) findSchedule(bucketKey, testId, scheduleId string) (*test, int) {
+	t := s.findTest(bucketKey, testId)
+	if t == nil {
+		return nil, -1
+	}
+	for i, schedule := range t.schedules {
+		if schedule.Id == scheduleId {
+			return t, i
+		}
+	}
+	return t, -1
+}
+
+func (s *Server) listSchedules(bucketKey, testId string) (int, interface{}) {
+	t := s.findTest(bucketKey, testId)
+	if t == nil {
+		return http.StatusNotFound, "Test not found"
+	}
+	return http.StatusOK, t.testData().Schedules
+}
+
+func (s *Server) createSchedule(r *request, bucketKey, testId string) (int, interface{}) {
+	t := s.findTest(bucketKey, testId)
+	if t == nil {
+		return http.StatusNotFound, "Test not found"
+	}
+
+	var body schema.ScheduleCreateRequest
+	if err := decodeBody(r, &body); err != nil {
+		return http.StatusBadRequest, err.Error()
+	}
+	if body.EnvironmentId == "" || body.Interval == "" {
+		return http.StatusBadRequest, "environment_id and interval are required"
+	}
+
+	schedule := &schema.Schedule{ScheduleBase: body.ScheduleBase, Id: newUUID(), ExportedAt: time.Now().Unix()}
+	t.schedules = append(t.schedules, schedule)
+
+	return http.StatusCreated, *schedule
+}
+
+func (s *Server) getSchedule(bucketKey, testId, scheduleId string) (int, interface{}) {
+	t, i := s.findSchedule(bucketKey, testId, scheduleId)
+	if i < 0 {
+		return http.StatusNotFound, "Schedule not found"
+	}
+	return http.StatusOK, *t.schedules[i]
+}
+
+func (s *Server) updateSchedule(r *request, bucketKey, testId, scheduleId string) (int, interface{}) {
+	t, i := s.findSchedule(bucketKey, testId, scheduleId)
+	if i < 0 {
+		return http.StatusNotFound, "Schedule not found"
+	}
+
+	var body schema.ScheduleUpdateRequest
+	if err := decodeBody(r, &body); err != nil {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	t.schedules[i].ScheduleBase = body.ScheduleBase
+	return http.StatusOK, *t.schedules[i]
+}
+
+func (s *Server) deleteSchedule(bucketKey, testId, scheduleId string) (int, interface{}) {
+	t, i := s.findSchedule(bucketKey, testId, scheduleId)
+	if i < 0 {
+		return http.StatusNotFound, "Schedule not found"
+	}
+	t.schedules = append(t.schedules[:i], t.schedules[i+1:]...)
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) triggerTest(r *request, triggerURL string) (int, interface{}) {
+	for _, b := range s.buckets {
+		for _, t := range b.tests {
+			if t.TriggerURL == triggerURL {
+				return http.StatusCreated, s.triggerRuns(r, b, []*test{t})
+			}
+		}
+	}
+	return http.StatusNotFound, "Trigger not found"
+}
+
+func (s *Server) triggerBucket(r *request, triggerURL string) (int, interface{}) {
+	for _, b := range s.buckets {
+		if b.TriggerURL == triggerURL {
+			return http.StatusCreated, s.triggerRuns(r, b, b.tests)
+		}
+	}
+	return http.StatusNotFound, "Trigger not found"
+}
+
+// triggerRuns starts runs of the tests. Query parameters of the request
+// override environment and its initial variables.
+func (s *Server) triggerRuns(r *request, b *bucket, tests []*test) schema.TestRunTrigger {
+	query := r.URL.Query()
+
+	trigger := schema.TestRunTrigger{Runs: []schema.TestRun{}}
+	for _, t := range tests {
+		envId := query.Get("runscope_environment")
+		if envId == "" {
+			envId = t.DefaultEnvironmentId
+		}
+
+		run := schema.TestRun{
+			TestRunId:     newUUID(),
+			TestId:        t.Id,
+			TestName:      t.Name,
+			BucketKey:     b.Key,
+			EnvironmentId: envId,
+			Region:        "us1",
+			Status:        "init",
+			Variables:     map[string]string{},
+		}
+		env := s.findEnvironment(b.Key, t.Id, envId)
+		if env == nil {
+			env = s.findEnvironment(b.Key, "", envId)
+		}
+		if env != nil {
+			run.EnvironmentName = env.Name
+			for name, value := range env.InitialVariables {
+				run.Variables[name] = value
+			}
+		}
+		for name := range query {
+			if !strings.HasPrefix(name, "runscope_") {
+				run.Variables[name] = query.Get(name)
+			}
+		}
+		run.TestURL = fmt.Sprintf("%s/radar/%s/%s", s.URL, b.Key, t.Id)
+		run.TestRunURL = fmt.Sprintf("%s/history/%s", run.TestURL, run.TestRunId)
+		run.URL = fmt.Sprintf("%s/buckets/%s/tests/%s/results/%s", s.URL, b.Key, t.Id, run.TestRunId)
+
+		res := &result{outcome: "pass"}
+		if run.Variables["fake_result"] == "fail" {
+			res.outcome = "fail"
+		}
+		res.data.TestRunId = run.TestRunId
+		res.data.TestId = t.Id
+		res.data.BucketKey = b.Key
+		res.data.EnvironmentId = run.EnvironmentId
+		res.data.EnvironmentName = run.EnvironmentName
+		res.data.Region = run.Region
+		res.data.Result = "working"
+		res.data.StartedAt = float64(time.Now().UnixNano()) / float64(time.Second)
+		res.data.TestRunURL = run.TestRunURL
+		for _, step := range t.steps {
+			if step.StepType != "request" {
+				continue
+			}
+			req := schema.ResultRequest{
+				UUID:   step.Id,
+				Method: step.Method,
+				URL:    step.URL,
+				Result: res.outcome,
+			}
+			req.AssertionsDefined = len(step.Assertions)
+			req.ScriptsDefined = len(step.Scripts)
+			req.VariablesDefined = len(step.Variables)
+			res.data.Requests = append(res.data.Requests, req)
+			res.data.AssertionsDefined += req.AssertionsDefined
+			res.data.ScriptsDefined += req.ScriptsDefined
+			res.data.VariablesDefined += req.VariablesDefined
+		}
+		t.results = append(t.results, res)
+
+		t.LastRun = &schema.TestLastRun{
+			Id:              run.TestRunId,
+			Status:          run.Status,
+			CreatedAt:       res.data.StartedAt,
+			EnvironmentId:   run.EnvironmentId,
+			EnvironmentName: run.EnvironmentName,
+			Region:          run.Region,
+			TestRunId:       run.TestRunId,
+			TestRunURL:      run.TestRunURL,
+		}
+
+		trigger.Runs = append(trigger.Runs, run)
+		trigger.RunsStarted++
+		trigger.RunsTotal++
+	}
+	return trigger
+}
+
+func (s *Server) getResult(bucketKey, testId, testRunId string) (int, interface{}) {
+	t := s.findTest(bucketKey, testId)
+	if t == nil {
+		return http.StatusNotFound, "Test not found"
+	}
+	for _, res := range t.results {
+		if res.data.TestRunId == testRunId {
+			data := res.data
+			res.finish()
+			return http.StatusOK, data
+		}
+	}
+	return http.StatusNotFound, "Result not found"
+}
+
+// listResults returns results of the test, most recent first. Requests
+// of the results are omitted as they are by the API.
+func (s *Server) listResults(r *request, bucketKey, testId string) (int, interface{}) {
+	t := s.findTest(bucketKey, testId)
+	if t == nil {
+		return http.StatusNotFound, "Test not found"
+	}
+
+	query := r.URL.Query()
+	count := 10
+	if v := query.Get("count"); v != "" {
+		count, _ = strconv.Atoi(v)
+	}
+	since, _ := strconv.ParseFloat(query.Get("since"), 64)
+	before, _ := strconv.ParseFloat(query.Get("before"), 64)
+
+	data := []schema.Result{}
+	for i := len(t.results) - 1; i >= 0 && len(data) < count; i-- {
+		res := t.results[i]
+		if res.data.StartedAt < since || before > 0 && res.data.StartedAt >= before {
+			continue
+		}
+		item := res.data
+		item.Requests = nil
+		data = append(data, item)
+		res.finish()
+	}
+	return http.StatusOK, data
+}
+
+// finish completes the run with its outcome.
+func (res *result) finish() {
+	if res.data.Result != "working" {
+		return
+	}
+
+	res.data.Result = res.outcome
+	res.data.FinishedAt = float64(time.Now().UnixNano()) / float64(time.Second)
+	res.data.RequestsExecuted = len(res.data.Requests)
+	if res.outcome == "pass" {
+		res.data.AssertionsPassed = res.data.AssertionsDefined
+		res.data.ScriptsPassed = res.data.ScriptsDefined
+		res.data.VariablesPassed = res.data.VariablesDefined
+	} else {
+		res.data.AssertionsFailed = res.data.AssertionsDefined
+		res.data.ScriptsFailed = res.data.ScriptsDefined
+		res.data.VariablesFailed = res.data.VariablesDefined
+	}
+	for i := range res.data.Requests {
+		req := &res.data.Requests[i]
+		if res.outcome == "pass" {
+			req.AssertionsPassed = req.AssertionsDefined
+			req.ScriptsPassed = req.ScriptsDefined
+			req.VariablesPassed = req.VariablesDefined
+		} else {
+			req.AssertionsFailed = req.AssertionsDefined
+			req.ScriptsFailed = req.ScriptsDefined
+			req.VariablesFailed = req.VariablesDefined
+		}
+	}
+}
+
+func decodeBody(r *request, v interface{}) error {
+	if r.Body == nil {
+		return nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("couldn't decode request body: %s", err)
+	}
+	return nil
+}
+
+func writeData(w http.ResponseWriter, status int, data interface{}) {
+	if status == http.StatusNoContent {
+		w.WriteHeader(status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":  data,
+		"meta":  map[string]string{"status": "success"},
+		"error": nil,
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data": nil,
+		"meta": map[string]string{"status": "error"},
+		"error": map[string]interface{}{
+			"status":  status,
+			"message": message,
+		},
+	})
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func newKey() string {
+	const alphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+	b := make([]byte, 12)
+	rand.Read(b)
+	for i := range b {
+		b[i] = alphabet[int(b[i])%len(alphabet)]
+	}
+	return string(b)
+}
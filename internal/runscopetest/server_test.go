@@ -0,0 +1,544 @@
+package runscopetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+)
+
+const testTeamId = "c8ffd67b-c281-45d3-9735-3f40ee567a02"
+
+func newTestClient(t *testing.T) (*Server, *runscope.Client) {
+	server := NewServer()
+	t.Cleanup(server.Close)
+	return server, runscope.NewClient(runscope.WithEndpoint(server.URL), runscope.WithRetryMax(0))
+}
+
+func TestServer_bucket(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: testTeamId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bucket.Name != "bucket" || bucket.Team.UUID != testTeamId || bucket.TriggerURL == "" {
+		t.Errorf("unexpected bucket %+v", bucket)
+	}
+
+	buckets, err := client.Bucket.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buckets) != 1 || buckets[0].Key != bucket.Key {
+		t.Errorf("expected list of created bucket, got %+v", buckets)
+	}
+
+	updateOpts := &runscope.BucketUpdateOpts{Name: "renamed", VerifySSL: false}
+	updateOpts.Key = bucket.Key
+	updated, err := client.Bucket.Update(ctx, updateOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Key != bucket.Key || updated.Name != "renamed" || updated.VerifySSL {
+		t.Errorf("unexpected updated bucket %+v", updated)
+	}
+	if !updated.Default || updated.AuthToken != bucket.AuthToken || updated.TriggerURL != bucket.TriggerURL || updated.Team != bucket.Team {
+		t.Errorf("expected update to keep other fields of %+v, got %+v", bucket, updated)
+	}
+
+	opts := &runscope.BucketDeleteOpts{}
+	opts.Key = bucket.Key
+	if err := client.Bucket.Delete(ctx, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Bucket.Get(ctx, &runscope.BucketGetOpts{Key: bucket.Key})
+	if !errors.Is(err, runscope.ErrNotFound) {
+		t.Errorf("expected 404 error, got %v", err)
+	}
+}
+
+func TestServer_testSteps(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: testTeamId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createOpts := runscope.TestCreateOpts{BucketId: bucket.Key}
+	createOpts.Name = "test"
+	test, err := client.Test.Create(ctx, createOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if test.DefaultEnvironmentId == "" {
+		t.Error("expected default environment to be created")
+	}
+
+	uriOpts := runscope.StepUriOpts{BucketId: bucket.Key, TestId: test.Id}
+	var stepIds []string
+	for _, url := range []string{"https://example.com/a", "https://example.com/b"} {
+		opts := &runscope.StepCreateOpts{StepUriOpts: uriOpts}
+		opts.StepType = "request"
+		opts.Method = "GET"
+		opts.StepURL = url
+		step, err := client.Step.Create(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if step.StepURL != url {
+			t.Errorf("expected created step url %s, got %s", url, step.StepURL)
+		}
+		stepIds = append(stepIds, step.Id)
+	}
+
+	updateOpts := &runscope.StepUpdateOpts{}
+	updateOpts.StepUriOpts = uriOpts
+	updateOpts.Id = stepIds[0]
+	updateOpts.StepType = "request"
+	updateOpts.Method = "POST"
+	updateOpts.StepURL = "https://example.com/c"
+	if _, err := client.Step.Update(ctx, updateOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	step, err := client.Step.Get(ctx, &runscope.StepGetOpts{StepUriOpts: uriOpts, Id: stepIds[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if step.Method != "POST" || step.StepURL != "https://example.com/c" {
+		t.Errorf("step wasn't updated: %+v", step)
+	}
+
+	test, err = client.Test.Get(ctx, runscope.TestGetOpts{BucketId: bucket.Key, Id: test.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(test.Steps) != 2 || test.Steps[0].Id != stepIds[0] || test.Steps[1].Id != stepIds[1] {
+		t.Errorf("unexpected test steps %+v", test.Steps)
+	}
+
+	reorderOpts := &runscope.StepReorderOpts{StepUriOpts: uriOpts, Ids: []string{stepIds[1], stepIds[0]}}
+	steps, err := client.Step.Reorder(ctx, reorderOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(steps) != 2 || steps[0].Id != stepIds[1] || steps[1].Id != stepIds[0] {
+		t.Errorf("steps weren't reordered: %+v", steps)
+	}
+
+	reorderOpts.Ids = []string{stepIds[0], stepIds[0]}
+	if _, err := client.Step.Reorder(ctx, reorderOpts); err == nil {
+		t.Error("expected error reordering steps listed twice")
+	}
+
+	deleteOpts := &runscope.StepDeleteOpts{}
+	deleteOpts.StepGetOpts = runscope.StepGetOpts{StepUriOpts: uriOpts, Id: stepIds[0]}
+	if err := client.Step.Delete(ctx, deleteOpts); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Step.Delete(ctx, deleteOpts); err == nil {
+		t.Error("expected error deleting deleted step")
+	}
+}
+
+func TestServer_conditionStep(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: testTeamId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createOpts := runscope.TestCreateOpts{BucketId: bucket.Key}
+	createOpts.Name = "test"
+	test, err := client.Test.Create(ctx, createOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &runscope.StepCreateOpts{StepUriOpts: runscope.StepUriOpts{BucketId: bucket.Key, TestId: test.Id}}
+	opts.StepType = "condition"
+	opts.LeftValue = "{{status}}"
+	opts.Comparison = "equal_number"
+	opts.RightValue = "200"
+	opts.Steps = []runscope.StepBaseOpts{{StepType: "request", Method: "GET", StepURL: "https://example.com"}}
+	step, err := client.Step.Create(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if step.StepType != "condition" || step.LeftValue != "{{status}}" || step.RightValue != "200" {
+		t.Errorf("unexpected step %+v", step)
+	}
+	if len(step.Steps) != 1 || step.Steps[0].Id == "" || step.Steps[0].StepURL != "https://example.com" {
+		t.Errorf("unexpected condition steps %+v", step.Steps)
+	}
+
+	updateOpts := &runscope.StepUpdateOpts{}
+	updateOpts.StepGetOpts = runscope.StepGetOpts{StepUriOpts: opts.StepUriOpts, Id: step.Id}
+	updateOpts.StepBaseOpts = opts.StepBaseOpts
+	updateOpts.Steps = nil
+	step, err = client.Step.Update(ctx, updateOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(step.Steps) != 0 {
+		t.Errorf("expected condition steps to be removed, got %+v", step.Steps)
+	}
+}
+
+func TestServer_environmentSchedule(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: testTeamId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testOpts := runscope.TestCreateOpts{BucketId: bucket.Key}
+	testOpts.Name = "test"
+	test, err := client.Test.Create(ctx, testOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	envOpts := &runscope.EnvironmentCreateOpts{}
+	envOpts.BucketId = bucket.Key
+	envOpts.Name = "shared"
+	envOpts.InitialVariables = map[string]string{"base_url": "https://example.com"}
+	env, err := client.Environment.Create(ctx, envOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getOpts := &runscope.EnvironmentGetOpts{Id: env.Id}
+	getOpts.BucketId = bucket.Key
+	if env, err = client.Environment.Get(ctx, getOpts); err != nil {
+		t.Fatal(err)
+	}
+	if env.InitialVariables["base_url"] != "https://example.com" {
+		t.Errorf("unexpected environment %+v", env)
+	}
+
+	getOpts.TestId = test.Id
+	if _, err = client.Environment.Get(ctx, getOpts); err == nil {
+		t.Error("expected bucket environment not to be found in test environments")
+	}
+
+	listOpts := &runscope.EnvironmentListOpts{}
+	listOpts.BucketId = bucket.Key
+	envs, err := client.Environment.List(ctx, listOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(envs) != 1 || envs[0].Id != env.Id {
+		t.Errorf("expected list of the shared environment, got %+v", envs)
+	}
+
+	listOpts.TestId = test.Id
+	if envs, err = client.Environment.List(ctx, listOpts); err != nil {
+		t.Fatal(err)
+	}
+	if len(envs) != 1 || envs[0].Id != test.DefaultEnvironmentId {
+		t.Errorf("expected list of the test default environment, got %+v", envs)
+	}
+
+	scheduleOpts := &runscope.ScheduleCreateOpts{}
+	scheduleOpts.BucketId = bucket.Key
+	scheduleOpts.TestId = test.Id
+	scheduleOpts.EnvironmentId = env.Id
+	scheduleOpts.Interval = "1h"
+	schedule, err := client.Schedule.Create(ctx, scheduleOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updateOpts := &runscope.ScheduleUpdateOpts{}
+	updateOpts.ScheduleURLOpts = scheduleOpts.ScheduleURLOpts
+	updateOpts.Id = schedule.Id
+	updateOpts.EnvironmentId = env.Id
+	updateOpts.Interval = "1d"
+	updateOpts.Note = "daily"
+	if schedule, err = client.Schedule.Update(ctx, updateOpts); err != nil {
+		t.Fatal(err)
+	}
+	if schedule.Interval != "1d" || schedule.Note != "daily" {
+		t.Errorf("schedule wasn't updated: %+v", schedule)
+	}
+
+	schedules, err := client.Schedule.List(ctx, &runscope.ScheduleListOpts{ScheduleURLOpts: scheduleOpts.ScheduleURLOpts})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules) != 1 || schedules[0].Id != schedule.Id || schedules[0].Interval != "1d" {
+		t.Errorf("expected list of the updated schedule, got %+v", schedules)
+	}
+}
+
+func TestServer_listTests(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: testTeamId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var testIds []string
+	for _, name := range []string{"a", "b", "c"} {
+		opts := runscope.TestCreateOpts{BucketId: bucket.Key}
+		opts.Name = name
+		test, err := client.Test.Create(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testIds = append(testIds, test.Id)
+	}
+
+	tests, err := client.Test.List(ctx, runscope.TestListOpts{BucketId: bucket.Key, Count: 2, Offset: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tests) != 2 || tests[0].Id != testIds[1] || tests[1].Id != testIds[2] {
+		t.Errorf("unexpected page of tests %+v", tests)
+	}
+
+	tests, err = client.Test.ListAll(ctx, runscope.TestListOpts{BucketId: bucket.Key, Count: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tests) != 3 {
+		t.Fatalf("expected all 3 tests, got %+v", tests)
+	}
+	for i, test := range tests {
+		if test.Id != testIds[i] {
+			t.Errorf("expected test %s at %d, got %s", testIds[i], i, test.Id)
+		}
+	}
+}
+
+func TestServer_testExportImport(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: testTeamId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testOpts := runscope.TestCreateOpts{BucketId: bucket.Key}
+	testOpts.Name = "test"
+	test, err := client.Test.Create(ctx, testOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stepOpts := &runscope.StepCreateOpts{StepUriOpts: runscope.StepUriOpts{BucketId: bucket.Key, TestId: test.Id}}
+	stepOpts.StepType = "request"
+	stepOpts.Method = "GET"
+	stepOpts.StepURL = "https://example.com"
+	if _, err := client.Step.Create(ctx, stepOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	envOpts := &runscope.EnvironmentCreateOpts{}
+	envOpts.BucketId = bucket.Key
+	envOpts.Name = "shared"
+	env, err := client.Environment.Create(ctx, envOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scheduleOpts := &runscope.ScheduleCreateOpts{}
+	scheduleOpts.BucketId = bucket.Key
+	scheduleOpts.TestId = test.Id
+	scheduleOpts.EnvironmentId = env.Id
+	scheduleOpts.Interval = "1h"
+	if _, err := client.Schedule.Create(ctx, scheduleOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	document, err := client.Test.Export(ctx, runscope.TestExportOpts{BucketId: bucket.Key, Id: test.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.TestRun.Trigger(ctx, &runscope.TestRunTriggerOpts{TriggerURL: test.TriggerURL}); err != nil {
+		t.Fatal(err)
+	}
+	exported, err := client.Test.Export(ctx, runscope.TestExportOpts{BucketId: bucket.Key, Id: test.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(exported) != string(document) {
+		t.Errorf("expected the same document after test run, got diff:\n%s\n%s", document, exported)
+	}
+
+	imported, err := client.Test.Import(ctx, runscope.TestImportOpts{BucketId: bucket.Key, Document: document})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported.Id == test.Id || imported.Name != "test" {
+		t.Errorf("unexpected imported test %+v", imported)
+	}
+	if len(imported.Steps) != 1 || imported.Steps[0].StepURL != "https://example.com" {
+		t.Errorf("unexpected imported steps %+v", imported.Steps)
+	}
+	if len(imported.Environments) != 2 {
+		t.Fatalf("expected test and shared environments to be imported, got %+v", imported.Environments)
+	}
+	if len(imported.Schedules) != 1 || imported.Schedules[0].EnvironmentId != imported.Environments[1].Id {
+		t.Errorf("unexpected imported schedules %+v", imported.Schedules)
+	}
+
+	if _, err := client.Test.Import(ctx, runscope.TestImportOpts{BucketId: bucket.Key, Document: []byte("{")}); err == nil {
+		t.Error("expected error importing invalid document")
+	}
+}
+
+func TestServer_testRun(t *testing.T) {
+	ctx := context.Background()
+	server, client := newTestClient(t)
+	server.Token = "secret"
+	client = runscope.NewClient(runscope.WithEndpoint(server.URL), runscope.WithToken("secret"), runscope.WithRetryMax(0))
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: testTeamId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createOpts := runscope.TestCreateOpts{BucketId: bucket.Key}
+	createOpts.Name = "test"
+	test, err := client.Test.Create(ctx, createOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stepOpts := &runscope.StepCreateOpts{StepUriOpts: runscope.StepUriOpts{BucketId: bucket.Key, TestId: test.Id}}
+	stepOpts.StepType = "request"
+	stepOpts.Method = "GET"
+	stepOpts.StepURL = "https://example.com"
+	stepOpts.Assertions = []runscope.StepAssertion{{Source: "response_status", Comparison: "equal_number", Value: "200"}}
+	if _, err := client.Step.Create(ctx, stepOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, outcome := range []string{"pass", "fail"} {
+		runs, err := client.TestRun.Trigger(ctx, &runscope.TestRunTriggerOpts{
+			TriggerURL: test.TriggerURL,
+			Variables:  map[string]string{"fake_result": outcome},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(runs) != 1 || runs[0].TestId != test.Id || runs[0].EnvironmentId != test.DefaultEnvironmentId {
+			t.Fatalf("unexpected runs %+v", runs)
+		}
+
+		opts := &runscope.ResultGetOpts{BucketId: bucket.Key, TestId: test.Id, Id: runs[0].TestRunId}
+		result, err := client.Result.Get(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Finished() {
+			t.Errorf("expected running result, got %+v", result)
+		}
+
+		result, err = client.Result.Get(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !result.Finished() || result.Result != outcome || result.AssertionsDefined != 1 || len(result.Requests) != 1 {
+			t.Errorf("expected %s result, got %+v", outcome, result)
+		}
+	}
+
+	triggerOpts := &runscope.BucketTriggerOpts{}
+	triggerOpts.Key = bucket.Key
+	runs, err := client.Bucket.Trigger(ctx, triggerOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 || runs[0].TestId != test.Id {
+		t.Errorf("expected run of the bucket test, got %+v", runs)
+	}
+
+	results, err := client.Result.List(ctx, &runscope.ResultListOpts{BucketId: bucket.Key, TestId: test.Id, Count: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].TestRunId != runs[0].TestRunId || results[1].Result != "fail" {
+		t.Errorf("expected two latest results, got %+v", results)
+	}
+}
+
+func TestServer_team(t *testing.T) {
+	ctx := context.Background()
+	server, client := newTestClient(t)
+
+	server.AddTeam(schema.AccountTeam{Id: testTeamId, Name: "Home"})
+	server.AddTeamMember(testTeamId, schema.TeamMember{Id: "3", Name: "member", Email: "member@example.com"})
+	server.AddIntegration(testTeamId, schema.Integration{UUID: "1", Type: "slack", Description: "Slack"})
+
+	account, err := client.Account.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.Email != "fake@example.com" || len(account.Teams) != 1 || account.Teams[0].UUID != testTeamId {
+		t.Errorf("unexpected account %+v", account)
+	}
+
+	teams, err := client.Team.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teams) != 1 || teams[0].UUID != testTeamId || teams[0].Name != "Home" {
+		t.Errorf("unexpected teams %+v", teams)
+	}
+
+	members, err := client.Team.ListMembers(ctx, &runscope.TeamMemberListOpts{TeamId: testTeamId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 1 || members[0].Email != "member@example.com" {
+		t.Errorf("unexpected team members %+v", members)
+	}
+	server.AddRemoteAgent(testTeamId, schema.RemoteAgent{Id: "2", Name: "agent", Version: "1.0"})
+
+	integrations, err := client.Integration.List(ctx, &runscope.IntegrationListOpts{TeamId: testTeamId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(integrations) != 1 || integrations[0].Type != "slack" {
+		t.Errorf("unexpected integrations %+v", integrations)
+	}
+
+	agents, err := client.RemoteAgent.List(ctx, &runscope.RemoteAgentListOpts{TeamUUID: testTeamId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(agents) != 1 || agents[0].Name != "agent" {
+		t.Errorf("unexpected remote agents %+v", agents)
+	}
+}
+
+func TestServer_token(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Token = "secret"
+
+	client := runscope.NewClient(runscope.WithEndpoint(server.URL), runscope.WithToken("invalid"))
+	_, err := client.Bucket.List(context.Background())
+	if !errors.Is(err, runscope.ErrUnauthorized) {
+		t.Errorf("expected 401 error, got %v", err)
+	}
+}